@@ -79,6 +79,8 @@ type DataResyncDSL interface {
 	ProxyArp(proxyArp *vpp_l3.ProxyARP) DataResyncDSL
 	// IPScanNeighbor adds L3 IP Scan Neighbor to the RESYNC request.
 	IPScanNeighbor(ipScanNeigh *vpp_l3.IPScanNeighbor) DataResyncDSL
+	// IPReassembly adds global IP reassembly settings to the RESYNC request.
+	IPReassembly(reass *vpp_l3.IPReassembly) DataResyncDSL
 	/*// L4Features adds L4 features to the RESYNC request
 	L4Features(val *vpp_l4.L4Features) DataResyncDSL
 	// AppNamespace adds VPP Application namespaces to the RESYNC request
@@ -93,6 +95,10 @@ type DataResyncDSL interface {
 	NAT44Interface(natIf *nat.Nat44Interface) DataResyncDSL
 	// NAT44AddressPool adds NAT44 address pool configuration to the RESYNC request.
 	NAT44AddressPool(pool *nat.Nat44AddressPool) DataResyncDSL
+	// NAT66Interface adds NAT66 interface configuration to the RESYNC request.
+	NAT66Interface(natIf *nat.Nat66Interface) DataResyncDSL
+	// NAT66StaticMapping adds NAT66 static mapping to the RESYNC request.
+	NAT66StaticMapping(mapping *nat.Nat66StaticMapping) DataResyncDSL
 	// IPSecSA adds request to RESYNC a new Security Association
 	IPSecSA(sa *ipsec.SecurityAssociation) DataResyncDSL
 	// IPSecSPD adds request to RESYNC a new Security Policy Database
@@ -197,6 +197,12 @@ func (dsl *PutDSL) IPScanNeighbor(ipScanNeigh *l3.IPScanNeighbor) linuxclient.Pu
 	return dsl
 }
 
+// IPReassembly adds a request to create or update global IP reassembly settings.
+func (dsl *PutDSL) IPReassembly(reass *l3.IPReassembly) linuxclient.PutDSL {
+	dsl.vppPut.IPReassembly(reass)
+	return dsl
+}
+
 /*// L4Features adds a request to enable or disable L4 features
 func (dsl *PutDSL) L4Features(val *l4.L4Features) linuxclient.PutDSL {
 	dsl.vppPut.L4Features(val)
@@ -239,6 +245,18 @@ func (dsl *PutDSL) NAT44AddressPool(pool *nat.Nat44AddressPool) linuxclient.PutD
 	return dsl
 }
 
+// NAT66Interface adds a request to create or update NAT66 interface configuration.
+func (dsl *PutDSL) NAT66Interface(natIf *nat.Nat66Interface) linuxclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(natIf), natIf)
+	return dsl
+}
+
+// NAT66StaticMapping adds a request to create or update NAT66 static mapping.
+func (dsl *PutDSL) NAT66StaticMapping(mapping *nat.Nat66StaticMapping) linuxclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(mapping), mapping)
+	return dsl
+}
+
 // IPSecSA adds request to create a new Security Association
 func (dsl *PutDSL) IPSecSA(sa *ipsec.SecurityAssociation) linuxclient.PutDSL {
 	dsl.vppPut.IPSecSA(sa)
@@ -385,6 +403,12 @@ func (dsl *DeleteDSL) IPScanNeighbor() linuxclient.DeleteDSL {
 	return dsl
 }
 
+// IPReassembly adds a request to reset global IP reassembly settings to defaults.
+func (dsl *DeleteDSL) IPReassembly() linuxclient.DeleteDSL {
+	dsl.vppDelete.IPReassembly()
+	return dsl
+}
+
 // Arp adds a request to delete an existing VPP L3 ARP.
 func (dsl *DeleteDSL) Arp(ifaceName string, ipAddr string) linuxclient.DeleteDSL {
 	dsl.vppDelete.Arp(ifaceName, ipAddr)
@@ -440,6 +464,18 @@ func (dsl *DeleteDSL) NAT44AddressPool(pool *nat.Nat44AddressPool) linuxclient.D
 	return dsl
 }
 
+// NAT66Interface adds a request to delete NAT66 interface configuration.
+func (dsl *DeleteDSL) NAT66Interface(natIf *nat.Nat66Interface) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(natIf))
+	return dsl
+}
+
+// NAT66StaticMapping adds a request to delete NAT66 static mapping.
+func (dsl *DeleteDSL) NAT66StaticMapping(mapping *nat.Nat66StaticMapping) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(mapping))
+	return dsl
+}
+
 // IPSecSA adds request to delete a Security Association
 func (dsl *DeleteDSL) IPSecSA(saIndex string) linuxclient.DeleteDSL {
 	dsl.vppDelete.IPSecSA(saIndex)
@@ -185,6 +185,13 @@ func (dsl *DataResyncDSL) IPScanNeighbor(ipScanNeigh *l3.IPScanNeighbor) linuxcl
 	return dsl
 }
 
+// IPReassembly adds global IP reassembly settings to the RESYNC request.
+func (dsl *DataResyncDSL) IPReassembly(reass *l3.IPReassembly) linuxclient.DataResyncDSL {
+	dsl.vppDataResync.IPReassembly(reass)
+
+	return dsl
+}
+
 /*// L4Features adds L4 features to the RESYNC request
 func (dsl *DataResyncDSL) L4Features(val *l4.L4Features) linuxclient.DataResyncDSL {
 	dsl.vppDataResync.L4Features(val)
@@ -233,6 +240,24 @@ func (dsl *DataResyncDSL) NAT44AddressPool(pool *nat.Nat44AddressPool) linuxclie
 	return dsl
 }
 
+// NAT66Interface adds NAT66 interface configuration to the RESYNC request.
+func (dsl *DataResyncDSL) NAT66Interface(natIf *nat.Nat66Interface) linuxclient.DataResyncDSL {
+	key := models.Key(natIf)
+	dsl.txn.Put(key, natIf)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// NAT66StaticMapping adds NAT66 static mapping to the RESYNC request.
+func (dsl *DataResyncDSL) NAT66StaticMapping(mapping *nat.Nat66StaticMapping) linuxclient.DataResyncDSL {
+	key := models.Key(mapping)
+	dsl.txn.Put(key, mapping)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
 // IPSecSA adds request to RESYNC a new Security Association
 func (dsl *DataResyncDSL) IPSecSA(sa *ipsec.SecurityAssociation) linuxclient.DataResyncDSL {
 	dsl.vppDataResync.IPSecSA(sa)
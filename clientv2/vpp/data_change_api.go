@@ -77,6 +77,8 @@ type PutDSL interface {
 	ProxyArp(proxyArp *l3.ProxyARP) PutDSL
 	// IPScanNeighbor adds L3 IP Scan Neighbor to the RESYNC request.
 	IPScanNeighbor(ipScanNeigh *l3.IPScanNeighbor) PutDSL
+	// IPReassembly adds a request to create or update global IP reassembly settings.
+	IPReassembly(reass *l3.IPReassembly) PutDSL
 	// StnRule adds a request to create or update Stn rule.
 	StnRule(stn *stn.Rule) PutDSL
 	// NAT44Global adds a request to set global configuration for NAT44
@@ -87,6 +89,10 @@ type PutDSL interface {
 	NAT44Interface(natIf *nat.Nat44Interface) PutDSL
 	// NAT44AddressPool adds a request to create or update NAT44 address pool.
 	NAT44AddressPool(pool *nat.Nat44AddressPool) PutDSL
+	// NAT66Interface adds a request to create or update NAT66 interface configuration.
+	NAT66Interface(natIf *nat.Nat66Interface) PutDSL
+	// NAT66StaticMapping adds a request to create or update NAT66 static mapping.
+	NAT66StaticMapping(mapping *nat.Nat66StaticMapping) PutDSL
 	// IPSecSA adds request to create a new Security Association
 	IPSecSA(sa *ipsec.SecurityAssociation) PutDSL
 	// IPSecSPD adds request to create a new Security Policy Database
@@ -134,6 +140,8 @@ type DeleteDSL interface {
 	ProxyArp() DeleteDSL
 	// IPScanNeighbor adds a request to delete an existing VPP L3 IP Scan Neighbor.
 	IPScanNeighbor() DeleteDSL
+	// IPReassembly adds a request to reset global IP reassembly settings to defaults.
+	IPReassembly() DeleteDSL
 	// StnRule adds a request to delete an existing Stn rule.
 	StnRule(iface, addr string) DeleteDSL
 	// NAT44Global adds a request to remove global configuration for NAT44
@@ -144,6 +152,10 @@ type DeleteDSL interface {
 	NAT44Interface(natIf *nat.Nat44Interface) DeleteDSL
 	// NAT44AddressPool adds a request to delete NAT44 address pool.
 	NAT44AddressPool(pool *nat.Nat44AddressPool) DeleteDSL
+	// NAT66Interface adds a request to delete NAT66 interface configuration.
+	NAT66Interface(natIf *nat.Nat66Interface) DeleteDSL
+	// NAT66StaticMapping adds a request to delete NAT66 static mapping.
+	NAT66StaticMapping(mapping *nat.Nat66StaticMapping) DeleteDSL
 	// IPSecSA adds request to delete a Security Association
 	IPSecSA(saIndex string) DeleteDSL
 	// IPSecSPD adds request to delete a Security Policy Database
@@ -57,6 +57,8 @@ type DataResyncDSL interface {
 	ProxyArp(proxyArp *l3.ProxyARP) DataResyncDSL
 	// IPScanNeighbor adds L3 IP Scan Neighbor to the RESYNC request.
 	IPScanNeighbor(ipScanNeigh *l3.IPScanNeighbor) DataResyncDSL
+	// IPReassembly adds global IP reassembly settings to the RESYNC request.
+	IPReassembly(reass *l3.IPReassembly) DataResyncDSL
 	// StnRule adds Stn rule to the RESYNC request.
 	StnRule(stn *stn.Rule) DataResyncDSL
 	// NAT44Global adds global NAT44 configuration to the RESYNC request.
@@ -67,6 +69,10 @@ type DataResyncDSL interface {
 	NAT44Interface(natIf *nat.Nat44Interface) DataResyncDSL
 	// NAT44AddressPool adds NAT44 address pool configuration to the RESYNC request.
 	NAT44AddressPool(pool *nat.Nat44AddressPool) DataResyncDSL
+	// NAT66Interface adds NAT66 interface configuration to the RESYNC request.
+	NAT66Interface(natIf *nat.Nat66Interface) DataResyncDSL
+	// NAT66StaticMapping adds NAT66 static mapping to the RESYNC request.
+	NAT66StaticMapping(mapping *nat.Nat66StaticMapping) DataResyncDSL
 	// IPSecSA adds request to RESYNC a new Security Association
 	IPSecSA(sa *ipsec.SecurityAssociation) DataResyncDSL
 	// IPSecSPD adds request to RESYNC a new Security Policy Database
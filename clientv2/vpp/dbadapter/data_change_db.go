@@ -150,6 +150,12 @@ func (dsl *PutDSL) IPScanNeighbor(ipScanNeigh *l3.IPScanNeighbor) vppclient.PutD
 	return dsl
 }
 
+// IPReassembly adds a request to create or update global IP reassembly settings.
+func (dsl *PutDSL) IPReassembly(reass *l3.IPReassembly) vppclient.PutDSL {
+	dsl.parent.txn.Put(l3.IPReassemblyKey(), reass)
+	return dsl
+}
+
 // StnRule adds a request to create or update STN rule.
 func (dsl *PutDSL) StnRule(val *stn.Rule) vppclient.PutDSL {
 	dsl.parent.txn.Put(stn.Key(val.Interface, val.IpAddress), val)
@@ -180,6 +186,18 @@ func (dsl *PutDSL) NAT44AddressPool(pool *nat.Nat44AddressPool) vppclient.PutDSL
 	return dsl
 }
 
+// NAT66Interface adds a request to create or update NAT66 interface configuration.
+func (dsl *PutDSL) NAT66Interface(natIf *nat.Nat66Interface) vppclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(natIf), natIf)
+	return dsl
+}
+
+// NAT66StaticMapping adds a request to create or update NAT66 static mapping.
+func (dsl *PutDSL) NAT66StaticMapping(mapping *nat.Nat66StaticMapping) vppclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(mapping), mapping)
+	return dsl
+}
+
 // IPSecSA adds request to create a new Security Association
 func (dsl *PutDSL) IPSecSA(sa *ipsec.SecurityAssociation) vppclient.PutDSL {
 	dsl.parent.txn.Put(ipsec.SAKey(sa.Index), sa)
@@ -294,6 +312,12 @@ func (dsl *DeleteDSL) IPScanNeighbor() vppclient.DeleteDSL {
 	return dsl
 }
 
+// IPReassembly adds a request to reset global IP reassembly settings to defaults.
+func (dsl *DeleteDSL) IPReassembly() vppclient.DeleteDSL {
+	dsl.parent.txn.Delete(l3.IPReassemblyKey())
+	return dsl
+}
+
 // StnRule adds request to delete Stn rule.
 func (dsl *DeleteDSL) StnRule(iface, addr string) vppclient.DeleteDSL {
 	dsl.parent.txn.Delete(stn.Key(iface, addr))
@@ -324,6 +348,18 @@ func (dsl *DeleteDSL) NAT44AddressPool(pool *nat.Nat44AddressPool) vppclient.Del
 	return dsl
 }
 
+// NAT66Interface adds a request to delete NAT66 interface configuration.
+func (dsl *DeleteDSL) NAT66Interface(natIf *nat.Nat66Interface) vppclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(natIf))
+	return dsl
+}
+
+// NAT66StaticMapping adds a request to delete NAT66 static mapping.
+func (dsl *DeleteDSL) NAT66StaticMapping(mapping *nat.Nat66StaticMapping) vppclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(mapping))
+	return dsl
+}
+
 // IPSecSA adds request to create a new Security Association
 func (dsl *DeleteDSL) IPSecSA(saIndex string) vppclient.DeleteDSL {
 	dsl.parent.txn.Delete(ipsec.SAKey(saIndex))
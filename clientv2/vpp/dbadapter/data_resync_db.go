@@ -158,6 +158,15 @@ func (dsl *DataResyncDSL) IPScanNeighbor(ipScanNeigh *l3.IPScanNeighbor) vppclie
 	return dsl
 }
 
+// IPReassembly adds global IP reassembly settings to the RESYNC request.
+func (dsl *DataResyncDSL) IPReassembly(reass *l3.IPReassembly) vppclient.DataResyncDSL {
+	key := l3.IPReassemblyKey()
+	dsl.txn.Put(key, reass)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
 // StnRule adds Stn rule to the RESYNC request.
 func (dsl *DataResyncDSL) StnRule(val *stn.Rule) vppclient.DataResyncDSL {
 	key := stn.Key(val.Interface, val.IpAddress)
@@ -203,6 +212,24 @@ func (dsl *DataResyncDSL) NAT44AddressPool(pool *nat.Nat44AddressPool) vppclient
 	return dsl
 }
 
+// NAT66Interface adds NAT66 interface configuration to the RESYNC request.
+func (dsl *DataResyncDSL) NAT66Interface(natIf *nat.Nat66Interface) vppclient.DataResyncDSL {
+	key := models.Key(natIf)
+	dsl.txn.Put(key, natIf)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// NAT66StaticMapping adds NAT66 static mapping to the RESYNC request.
+func (dsl *DataResyncDSL) NAT66StaticMapping(mapping *nat.Nat66StaticMapping) vppclient.DataResyncDSL {
+	key := models.Key(mapping)
+	dsl.txn.Put(key, mapping)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
 // IPSecSA adds request to create a new Security Association
 func (dsl *DataResyncDSL) IPSecSA(sa *ipsec.SecurityAssociation) vppclient.DataResyncDSL {
 	key := ipsec.SAKey(sa.Index)
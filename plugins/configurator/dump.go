@@ -105,6 +105,11 @@ func (svc *dumpService) Dump(ctx context.Context, req *rpc.DumpRequest) (*rpc.Du
 		svc.log.Errorf("DumpARPs failed: %v", err)
 		return nil, err
 	}
+	dump.VppConfig.IpReassembly, err = svc.DumpIPReassembly()
+	if err != nil {
+		svc.log.Errorf("DumpIPReassembly failed: %v", err)
+		return nil, err
+	}
 	dump.VppConfig.IpsecSpds, err = svc.DumpIPSecSPDs()
 	if err != nil {
 		svc.log.Errorf("DumpIPSecSPDs failed: %v", err)
@@ -147,6 +152,16 @@ func (svc *dumpService) Dump(ctx context.Context, req *rpc.DumpRequest) (*rpc.Du
 		svc.log.Errorf("DumpNAT44AddressPools failed: %v", err)
 		return nil, err
 	}
+	dump.VppConfig.Nat66Interfaces, err = svc.DumpNAT66Interfaces()
+	if err != nil {
+		svc.log.Errorf("DumpNAT66Interfaces failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Nat66StaticMappings, err = svc.DumpNAT66StaticMappings()
+	if err != nil {
+		svc.log.Errorf("DumpNAT66StaticMappings failed: %v", err)
+		return nil, err
+	}
 	dump.VppConfig.PuntTohosts, err = svc.DumpPunt()
 	if err != nil {
 		svc.log.Errorf("DumpPunt failed: %v", err)
@@ -323,6 +338,20 @@ func (svc *dumpService) DumpARPs() (arps []*vpp_l3.ARPEntry, err error) {
 	return arps, nil
 }
 
+// DumpIPReassembly reads global IP reassembly settings and returns them as an *IPReassembly.
+func (svc *dumpService) DumpIPReassembly() (reass *vpp_l3.IPReassembly, err error) {
+	if svc.l3Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	reass, err = svc.l3Handler.GetIPReassembly()
+	if err != nil {
+		return nil, err
+	}
+	return reass, nil
+}
+
 // DumpACLs reads IP/MACIP access lists and returns them as an *AclResponse. If reading ends up with error,
 // only error is send back in response
 func (svc *dumpService) DumpACLs() (acls []*vpp_acl.ACL, err error) {
@@ -422,6 +451,34 @@ func (svc *dumpService) DumpNAT44AddressPools() (natPools []*vpp_nat.Nat44Addres
 	return natPools, nil
 }
 
+// DumpNAT66Interfaces dumps NAT66Interfaces
+func (svc *dumpService) DumpNAT66Interfaces() (natIfs []*vpp_nat.Nat66Interface, err error) {
+	if svc.natHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	natIfs, err = svc.natHandler.Nat66InterfacesDump()
+	if err != nil {
+		return nil, err
+	}
+	return natIfs, nil
+}
+
+// DumpNAT66StaticMappings dumps NAT66StaticMappings
+func (svc *dumpService) DumpNAT66StaticMappings() (mappings []*vpp_nat.Nat66StaticMapping, err error) {
+	if svc.natHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	mappings, err = svc.natHandler.Nat66StaticMappingsDump()
+	if err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
+
 // DumpPunt reads VPP Punt socket registrations and returns them as an *PuntResponse.
 func (svc *dumpService) DumpPunt() (punts []*vpp_punt.ToHost, err error) {
 	if svc.puntHandler == nil {
@@ -17,6 +17,7 @@ package kvscheduler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"runtime/trace"
 	"sync"
@@ -65,6 +66,13 @@ const (
 	// to stdout
 	defaultPrintTxnSummary = true
 
+	// by default, SB is not periodically checked for changes applied out-of-band
+	// (i.e. not by the agent)
+	defaultPeriodicDownstreamResync = 0 // disabled
+
+	// by default, changes detected by the periodic downstream resync are reverted
+	defaultPeriodicDownstreamResyncAction = RevertOutOfBandChanges
+
 	// name of the environment variable used to enable verification after every transaction
 	verifyModeEnv = "KVSCHED_VERIFY_MODE"
 
@@ -77,6 +85,17 @@ const (
 	logGraphWalkEnv = "KVSCHED_LOG_GRAPH_WALK"
 )
 
+// Actions that can be taken by the periodic downstream resync for changes
+// applied to SB out-of-band.
+const (
+	// RevertOutOfBandChanges reverts SB back to the desired state.
+	RevertOutOfBandChanges = "revert"
+
+	// ReportOutOfBandChanges only logs and counts the detected changes,
+	// SB is left untouched.
+	ReportOutOfBandChanges = "report"
+)
+
 // Scheduler is a CN-infra plugin implementing KVScheduler.
 // Detailed documentation can be found in the "api" and "docs" sub-folders.
 type Scheduler struct {
@@ -127,11 +146,13 @@ type Deps struct {
 
 // Config holds the KVScheduler configuration.
 type Config struct {
-	RecordTransactionHistory      bool   `json:"record-transaction-history"`
-	TransactionHistoryAgeLimit    uint32 `json:"transaction-history-age-limit"`    // in minutes
-	PermanentlyRecordedInitPeriod uint32 `json:"permanently-recorded-init-period"` // in minutes
-	EnableTxnSimulation           bool   `json:"enable-txn-simulation"`
-	PrintTxnSummary               bool   `json:"print-txn-summary"`
+	RecordTransactionHistory       bool   `json:"record-transaction-history"`
+	TransactionHistoryAgeLimit     uint32 `json:"transaction-history-age-limit"`    // in minutes
+	PermanentlyRecordedInitPeriod  uint32 `json:"permanently-recorded-init-period"` // in minutes
+	EnableTxnSimulation            bool   `json:"enable-txn-simulation"`
+	PrintTxnSummary                bool   `json:"print-txn-summary"`
+	PeriodicDownstreamResync       uint32 `json:"periodic-downstream-resync"`        // in seconds, 0 = disabled
+	PeriodicDownstreamResyncAction string `json:"periodic-downstream-resync-action"` // revert or report
 }
 
 // SchedulerTxn implements transaction for the KV scheduler.
@@ -151,11 +172,13 @@ type valStateWatcher struct {
 func (s *Scheduler) Init() error {
 	// default configuration
 	s.config = &Config{
-		RecordTransactionHistory:      defaultRecordTransactionHistory,
-		TransactionHistoryAgeLimit:    defaultTransactionHistoryAgeLimit,
-		PermanentlyRecordedInitPeriod: defaultPermanentlyRecordedInitPeriod,
-		EnableTxnSimulation:           defaultEnableTxnSimulation,
-		PrintTxnSummary:               defaultPrintTxnSummary,
+		RecordTransactionHistory:       defaultRecordTransactionHistory,
+		TransactionHistoryAgeLimit:     defaultTransactionHistoryAgeLimit,
+		PermanentlyRecordedInitPeriod:  defaultPermanentlyRecordedInitPeriod,
+		EnableTxnSimulation:            defaultEnableTxnSimulation,
+		PrintTxnSummary:                defaultPrintTxnSummary,
+		PeriodicDownstreamResync:       defaultPeriodicDownstreamResync,
+		PeriodicDownstreamResyncAction: defaultPeriodicDownstreamResyncAction,
 	}
 
 	// load configuration
@@ -165,6 +188,14 @@ func (s *Scheduler) Init() error {
 		return err
 	}
 	s.Log.Debugf("KVScheduler configuration: %+v", *s.config)
+	switch s.config.PeriodicDownstreamResyncAction {
+	case RevertOutOfBandChanges, ReportOutOfBandChanges:
+	default:
+		err = fmt.Errorf("invalid periodic downstream resync action: %q",
+			s.config.PeriodicDownstreamResyncAction)
+		s.Log.Error(err)
+		return err
+	}
 
 	// prepare context for all go routines
 	s.ctx, s.cancel = context.WithCancel(context.Background())
@@ -200,6 +231,12 @@ func (s *Scheduler) Init() error {
 		s.wg.Add(1)
 		go s.transactionHistoryTrimming()
 	}
+
+	// go routine periodically reverting/reporting changes applied to SB out-of-band
+	if s.config.PeriodicDownstreamResync > 0 {
+		s.wg.Add(1)
+		go s.periodicDownstreamResync()
+	}
 	return nil
 }
 
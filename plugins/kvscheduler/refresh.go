@@ -17,6 +17,7 @@ package kvscheduler
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
@@ -575,3 +576,72 @@ func (s *Scheduler) validRetrievedDerivedKV(node graph.Node, descriptor *kvs.KVD
 	}
 	return true
 }
+
+// outOfBandChange describes a difference between the state of a value in SB
+// and the state in which the value was left by the scheduler.
+type outOfBandChange struct {
+	key    string
+	change string
+}
+
+const (
+	outOfBandMissing    = "missing"    // configured value was removed
+	outOfBandModified   = "modified"   // configured value was changed
+	outOfBandUnexpected = "unexpected" // value not known to the scheduler was created
+)
+
+// detectOutOfBandChanges compares the current state of SB, obtained using
+// the Retrieve methods from descriptors, with the in-memory graph. Neither SB
+// nor the graph are changed.
+func (s *Scheduler) detectOutOfBandChanges() (changes []outOfBandChange) {
+	// pause transaction processing
+	s.txnLock.Lock()
+	defer s.txnLock.Unlock()
+
+	graphR := s.graph.Read()
+	defer graphR.Release()
+
+	for _, descriptor := range s.registry.GetAllDescriptors() {
+		handler := newDescriptorHandler(descriptor)
+		inMemNodes := graphR.GetNodes(nil, descrValsSelectors(descriptor.Name, true)...)
+		retrieved, ableToRetrieve, err := handler.retrieve(nodesToKVPairsWithMetadata(inMemNodes))
+		if !ableToRetrieve {
+			continue
+		}
+		if err != nil {
+			s.Log.WithField("descriptor", descriptor.Name).
+				Warnf("failed to retrieve values: %v", err)
+			continue
+		}
+
+		sbValues := make(map[string]kvs.KVWithMetadata)
+		for _, kv := range retrieved {
+			sbValues[kv.Key] = kv
+		}
+		for _, node := range inMemNodes {
+			if getNodeState(node) != kvscheduler.ValueState_CONFIGURED {
+				continue
+			}
+			key := node.GetKey()
+			sbValue, inSB := sbValues[key]
+			delete(sbValues, key)
+			if !inSB {
+				changes = append(changes, outOfBandChange{key: key, change: outOfBandMissing})
+			} else if !handler.equivalentValues(key, node.GetValue(), sbValue.Value) {
+				changes = append(changes, outOfBandChange{key: key, change: outOfBandModified})
+			}
+		}
+		var unexpected []string
+		for key, kv := range sbValues {
+			// values obtained from SB are never removed by resync
+			if kv.Origin == kvs.FromNB && graphR.GetNode(key) == nil {
+				unexpected = append(unexpected, key)
+			}
+		}
+		sort.Strings(unexpected)
+		for _, key := range unexpected {
+			changes = append(changes, outOfBandChange{key: key, change: outOfBandUnexpected})
+		}
+	}
+	return changes
+}
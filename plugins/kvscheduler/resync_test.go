@@ -2028,6 +2028,109 @@ func TestResyncWithRetry(t *testing.T) {
 	Expect(err).To(BeNil())
 }
 
+// prepareOutOfBandChanges configures three values and then changes SB behind
+// the scheduler's back: one value is modified, one removed and one added.
+func prepareOutOfBandChanges(action string) (*Scheduler, *test.MockSouthbound) {
+	// prepare KV Scheduler
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+	scheduler.config.PeriodicDownstreamResyncAction = action
+
+	// prepare mocks
+	mockSB := test.NewMockSouthbound()
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: proto.MessageName(test.NewStringValue("")),
+	}, mockSB, 0)
+	scheduler.RegisterKVDescriptor(descriptor1)
+
+	// run startup resync
+	schedulerTxn := scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixA+baseValue1, test.NewStringValue("value1"))
+	schedulerTxn.SetValue(prefixA+baseValue2, test.NewStringValue("value2"))
+	schedulerTxn.SetValue(prefixA+baseValue3, test.NewStringValue("value3"))
+	_, err = schedulerTxn.Commit(WithResync(testCtx, FullResync, true))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// change SB out-of-band
+	mockSB.SetValue(prefixA+baseValue1, test.NewStringValue("modified"), nil, FromNB, false)
+	mockSB.SetValue(prefixA+baseValue2, nil, nil, FromNB, false)
+	mockSB.SetValue(prefixA+baseValue4, test.NewStringValue("value4"), nil, FromNB, false)
+	mockSB.PopHistoryOfOps()
+	return scheduler, mockSB
+}
+
+func TestPeriodicDownstreamResyncRevert(t *testing.T) {
+	RegisterTestingT(t)
+	scheduler, mockSB := prepareOutOfBandChanges(RevertOutOfBandChanges)
+	outOfBandStats := GetStats().OutOfBandChanges
+
+	scheduler.handleOutOfBandChanges()
+
+	// check the state of SB
+	Expect(mockSB.GetKeysWithInvalidData()).To(BeEmpty())
+	Expect(mockSB.GetValues(nil)).To(HaveLen(3))
+	for key, expValue := range map[string]string{
+		prefixA + baseValue1: "value1",
+		prefixA + baseValue2: "value2",
+		prefixA + baseValue3: "value3",
+	} {
+		value := mockSB.GetValue(key)
+		Expect(value).ToNot(BeNil(), key)
+		Expect(proto.Equal(value.Value, test.NewStringValue(expValue))).To(BeTrue(), key)
+	}
+	Expect(mockSB.GetValue(prefixA + baseValue4)).To(BeNil())
+
+	// changes were reverted, not reported
+	Expect(GetStats().OutOfBandChanges).To(Equal(outOfBandStats))
+
+	// close scheduler
+	err := scheduler.Close()
+	Expect(err).To(BeNil())
+}
+
+func TestPeriodicDownstreamResyncReport(t *testing.T) {
+	RegisterTestingT(t)
+	scheduler, mockSB := prepareOutOfBandChanges(ReportOutOfBandChanges)
+	outOfBandStats := GetStats().OutOfBandChanges
+
+	// detect changes
+	changes := scheduler.detectOutOfBandChanges()
+	Expect(changes).To(ConsistOf(
+		outOfBandChange{key: prefixA + baseValue1, change: outOfBandModified},
+		outOfBandChange{key: prefixA + baseValue2, change: outOfBandMissing},
+		outOfBandChange{key: prefixA + baseValue4, change: outOfBandUnexpected},
+	))
+
+	// report changes
+	mockSB.PopHistoryOfOps()
+	scheduler.handleOutOfBandChanges()
+	Expect(GetStats().OutOfBandChanges).To(Equal(outOfBandStats + 3))
+
+	// SB was only retrieved, not changed
+	opHistory := mockSB.PopHistoryOfOps()
+	Expect(opHistory).To(HaveLen(1))
+	Expect(opHistory[0].OpType).To(Equal(test.MockRetrieve))
+	Expect(mockSB.GetValues(nil)).To(HaveLen(3))
+	value := mockSB.GetValue(prefixA + baseValue1)
+	Expect(value).ToNot(BeNil())
+	Expect(proto.Equal(value.Value, test.NewStringValue("modified"))).To(BeTrue())
+	Expect(mockSB.GetValue(prefixA + baseValue2)).To(BeNil())
+	Expect(mockSB.GetValue(prefixA + baseValue4)).ToNot(BeNil())
+
+	// the graph was not changed either
+	Expect(scheduler.detectOutOfBandChanges()).To(HaveLen(3))
+
+	// close scheduler
+	err := scheduler.Close()
+	Expect(err).To(BeNil())
+}
+
 /* when graph dump is needed:
 graphR := scheduler.graph.Read()
 graphDump := graphR.Dump()
@@ -62,6 +62,8 @@ type Stats struct {
 	GraphMethods   StructStats
 	AllDescriptors StructStats
 	Descriptors    map[string]*StructStats
+	// changes applied to SB out-of-band as reported by periodic downstream resync
+	OutOfBandChanges uint64
 }
 
 func (s *Stats) addDescriptor(name string) {
@@ -152,6 +154,12 @@ func updateTransactionStats(execOps kvs.RecordedTxnOps) {
 	}
 }
 
+func updateOutOfBandStats(changes int) {
+	statsMu.Lock()
+	defer statsMu.Unlock()
+	stats.OutOfBandChanges += uint64(changes)
+}
+
 func init() {
 	expvar.Publish("kvscheduler", expvar.Func(func() interface{} {
 		return GetStats()
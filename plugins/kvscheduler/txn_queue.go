@@ -85,3 +85,48 @@ func (s *Scheduler) delayRetry(args *retryTxn) {
 		}
 	}
 }
+
+// periodicDownstreamResync periodically checks SB for changes applied out-of-band
+// (i.e. not by the agent). Depending on the configured action, the changes are
+// either reverted by downstream resync (operations executed to revert SB back
+// to the desired state are printed in the transaction summary) or only reported.
+func (s *Scheduler) periodicDownstreamResync() {
+	defer s.wg.Done()
+
+	period := time.Duration(s.config.PeriodicDownstreamResync) * time.Second
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(period):
+			s.txnLock.Lock()
+			resyncCount := s.resyncCount
+			s.txnLock.Unlock()
+			if resyncCount == 0 {
+				// wait for the startup resync with NB
+				continue
+			}
+			s.handleOutOfBandChanges()
+		}
+	}
+}
+
+// handleOutOfBandChanges runs one round of the periodic downstream resync.
+func (s *Scheduler) handleOutOfBandChanges() {
+	if s.config.PeriodicDownstreamResyncAction == ReportOutOfBandChanges {
+		changes := s.detectOutOfBandChanges()
+		for _, change := range changes {
+			s.Log.WithFields(logging.Fields{
+				"key":    change.key,
+				"change": change.change,
+			}).Warn("Detected change applied to SB out-of-band")
+		}
+		updateOutOfBandStats(len(changes))
+		return
+	}
+	ctx := kvs.WithResync(context.Background(), kvs.DownstreamResync, false)
+	ctx = kvs.WithDescription(ctx, "periodic downstream resync")
+	if _, err := s.StartNBTransaction().Commit(ctx); err != nil {
+		s.Log.Warnf("periodic downstream resync failed: %v", err)
+	}
+}
@@ -35,6 +35,11 @@ var (
 type Config struct {
 	MTU              uint32   `json:"mtu"`
 	StatusPublishers []string `json:"status-publishers"`
+	StatusFilter     []string `json:"status-filter"`
+	MacPool          string   `json:"mac-pool"`
+	// period (in milliseconds) for which interface state changes are held
+	// back from subscribers to filter flapping, 0 = disabled
+	StateSubscriptionDebounce uint32 `json:"state-subscription-debounce"`
 }
 
 // DefaultConfig returns Config with default values.
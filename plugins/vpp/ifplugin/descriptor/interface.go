@@ -152,12 +152,21 @@ var (
 
 	// ErrGtpuDstAddrBad is returned when destination address was not set to valid IP address.
 	ErrGtpuDstAddrBad = errors.Errorf("bad destination address for GTPU tunnel")
+
+	// ErrMacPoolNotConfigured is returned when MAC address is requested to be allocated
+	// from the MAC pool, but the pool is not configured.
+	ErrMacPoolNotConfigured = errors.Errorf("MAC pool is not configured")
+
+	// ErrMacPoolUnsupportedType is returned when MAC address is requested to be allocated
+	// from the MAC pool for interface type which does not support MAC address change.
+	ErrMacPoolUnsupportedType = errors.Errorf("MAC address from the pool is not supported for this interface type")
 )
 
 // InterfaceDescriptor teaches KVScheduler how to configure VPP interfaces.
 type InterfaceDescriptor struct {
 	// config
 	defaultMtu uint32
+	macPool    *macPool // nil if MAC pool is not configured
 
 	// dependencies
 	log       logging.Logger
@@ -176,6 +185,9 @@ type InterfaceDescriptor struct {
 	bondIDs                map[uint32]string // bond ID to name (ID != sw_if_idx)
 	ethernetIfs            map[string]uint32 // name-to-index map of ethernet interfaces (entry is not
 	// removed even if interface is un-configured)
+	vppRun        func() string             // identifies VPP instance (nil if not provided)
+	appliedVPPRun string                    // VPP instance the appliedAttrs belong to
+	appliedAttrs  map[string]appliedIfAttrs // interface name -> attributes applied by the agent
 }
 
 // LinuxPluginAPI is defined here to avoid import cycles.
@@ -247,6 +259,21 @@ func (d *InterfaceDescriptor) SetInterfaceIndex(intfIndex ifaceidx.IfaceMetadata
 	d.intfIndex = intfIndex
 }
 
+// SetVPPRunIdentifier provides a function returning an identifier of the VPP
+// instance the agent is connected to, which changes when VPP is restarted.
+// Interface attributes that cannot be dumped from VPP are then reported
+// as unset after VPP restart, so that they get re-applied.
+func (d *InterfaceDescriptor) SetVPPRunIdentifier(vppRun func() string) {
+	d.vppRun = vppRun
+}
+
+// SetMacPool enables allocation of MAC addresses from the range given by OUI
+// (e.g. "02:fe:00"). Should be called before the first transaction.
+func (d *InterfaceDescriptor) SetMacPool(oui string) (err error) {
+	d.macPool, err = newMacPool(oui)
+	return err
+}
+
 // EquivalentInterfaces is case-insensitive comparison function for
 // interfaces.Interface, also ignoring the order of assigned IP addresses.
 func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf *interfaces.Interface) bool {
@@ -254,7 +281,10 @@ func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf
 	if oldIntf.Name != newIntf.Name ||
 		oldIntf.Type != newIntf.Type ||
 		oldIntf.Enabled != newIntf.Enabled ||
-		oldIntf.SetDhcpClient != newIntf.SetDhcpClient {
+		oldIntf.SetDhcpClient != newIntf.SetDhcpClient ||
+		oldIntf.DirectedBroadcast != newIntf.DirectedBroadcast ||
+		oldIntf.Ipv6Enabled != newIntf.Ipv6Enabled ||
+		oldIntf.DetailedStats != newIntf.DetailedStats {
 		return false
 	}
 	if !proto.Equal(oldIntf.Unnumbered, newIntf.Unnumbered) {
@@ -267,7 +297,12 @@ func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf
 	}
 
 	if newIntf.Unnumbered == nil { // unnumbered inherits VRF from numbered interface
-		if oldIntf.Vrf != newIntf.Vrf {
+		// VRF for IPv4 is irrelevant if the interface has IPv6 addresses only
+		hasIPv4, hasIPv6 := getIPAddressVersions(newIntf.IpAddresses)
+		if (hasIPv4 || !hasIPv6) && oldIntf.Vrf != newIntf.Vrf {
+			return false
+		}
+		if hasIPv6 && oldIntf.GetVrfForIpv6() != newIntf.GetVrfForIpv6() {
 			return false
 		}
 	}
@@ -552,6 +587,16 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 		}
 	}
 
+	// validate MAC address allocated from the pool
+	if intf.GetPhysAddress() == interfaces.PhysAddressFromPool {
+		if d.macPool == nil {
+			return kvs.NewInvalidValueError(ErrMacPoolNotConfigured, "phys_address")
+		}
+		if intf.GetType() == interfaces.Interface_DPDK {
+			return kvs.NewInvalidValueError(ErrMacPoolUnsupportedType, "phys_address")
+		}
+	}
+
 	// validate unnumbered
 	if intf.GetUnnumbered() != nil {
 		if len(intf.GetIpAddresses()) > 0 {
@@ -793,9 +838,24 @@ func (d *InterfaceDescriptor) DerivedValues(key string, intf *interfaces.Interfa
 			// not VXLAN tunnel
 			hasIPv4, hasIPv6 = getIPAddressVersions(intf.IpAddresses)
 		}
-		if hasIPv4 || hasIPv6 {
+		if hasIPv4 && hasIPv6 && intf.GetVrf() != intf.GetVrfForIpv6() {
+			// different VRF table for each IP version
+			derValues = append(derValues, kvs.KeyValuePair{
+				Key:   interfaces.InterfaceVrfKey(intf.GetName(), int(intf.GetVrf()), true, false),
+				Value: &prototypes.Empty{},
+			})
+			derValues = append(derValues, kvs.KeyValuePair{
+				Key:   interfaces.InterfaceVrfKey(intf.GetName(), int(intf.GetVrfForIpv6()), false, true),
+				Value: &prototypes.Empty{},
+			})
+		} else if hasIPv4 {
+			derValues = append(derValues, kvs.KeyValuePair{
+				Key:   interfaces.InterfaceVrfKey(intf.GetName(), int(intf.GetVrf()), true, hasIPv6),
+				Value: &prototypes.Empty{},
+			})
+		} else if hasIPv6 {
 			derValues = append(derValues, kvs.KeyValuePair{
-				Key:   interfaces.InterfaceVrfKey(intf.GetName(), int(intf.GetVrf()), hasIPv4, hasIPv6),
+				Key:   interfaces.InterfaceVrfKey(intf.GetName(), int(intf.GetVrfForIpv6()), false, true),
 				Value: &prototypes.Empty{},
 			})
 		}
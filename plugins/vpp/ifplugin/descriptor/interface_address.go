@@ -15,6 +15,8 @@
 package descriptor
 
 import (
+	"strings"
+
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"go.ligato.io/cn-infra/v2/logging"
@@ -134,11 +136,24 @@ func (d *InterfaceAddressDescriptor) Delete(key string, emptyVal proto.Message,
 // allocation of the IP address as dependencies.
 func (d *InterfaceAddressDescriptor) Dependencies(key string, emptyVal proto.Message) []kvs.Dependency {
 	iface, addr, _, _, _ := interfaces.ParseInterfaceAddressKey(key)
+	vrfDep := kvs.AnyOfDependency{
+		KeyPrefixes: []string{interfaces.InterfaceVrfKeyPrefix(iface)},
+	}
+	if !strings.HasPrefix(addr, netalloc_api.AllocRefPrefix) {
+		// the address has to be added only after the interface was assigned
+		// to the VRF table of the same IP version
+		isIPv6 := strings.Contains(addr, ":")
+		vrfDep.KeySelector = func(key string) bool {
+			if _, _, ipv4, ipv6, isVrfKey := interfaces.ParseInterfaceVrfKey(key); isVrfKey {
+				return (isIPv6 && ipv6) || (!isIPv6 && ipv4)
+			}
+			// VRF inherited from numbered interface
+			return true
+		}
+	}
 	deps := []kvs.Dependency{{
 		Label: interfaceInVrfDep,
-		AnyOf: kvs.AnyOfDependency{
-			KeyPrefixes: []string{interfaces.InterfaceVrfKeyPrefix(iface)},
-		},
+		AnyOf: vrfDep,
 	}}
 
 	allocDep, hasAllocDep := d.addrAlloc.GetAddressAllocDep(addr, iface, "")
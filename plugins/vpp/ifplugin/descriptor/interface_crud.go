@@ -22,6 +22,21 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 
 	ctx := context.TODO()
 
+	// allocate MAC address from the pool if requested
+	physAddress := intf.GetPhysAddress()
+	if physAddress == interfaces.PhysAddressFromPool {
+		if physAddress, err = d.macPool.allocate(intf.Name); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+		// return the address back to the pool if the interface was not created
+		defer func() {
+			if err != nil {
+				d.macPool.release(intf.Name)
+			}
+		}()
+	}
+
 	// create the interface of the given type
 	switch intf.Type {
 	case interfaces.Interface_TAP:
@@ -116,7 +131,7 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 			d.log.Error(err)
 			return nil, err
 		}
-		ifIdx, err = d.ifHandler.AddAfPacketInterface(intf.Name, intf.GetPhysAddress(), targetHostIfName)
+		ifIdx, err = d.ifHandler.AddAfPacketInterface(intf.Name, physAddress, targetHostIfName)
 		if err != nil {
 			d.log.Error(err)
 			return nil, err
@@ -153,7 +168,7 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 			return nil, err
 		}
 	case interfaces.Interface_BOND_INTERFACE:
-		ifIdx, err = d.ifHandler.AddBondInterface(intf.Name, intf.PhysAddress, intf.GetBond())
+		ifIdx, err = d.ifHandler.AddBondInterface(intf.Name, physAddress, intf.GetBond())
 		if err != nil {
 			d.log.Error(err)
 			return nil, err
@@ -193,13 +208,13 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 
 	// MAC address. Note: physical interfaces cannot have the MAC address changed. The bond interface uses its own
 	// binary API call to set MAC address.
-	if intf.GetPhysAddress() != "" &&
+	if physAddress != "" &&
 		intf.GetType() != interfaces.Interface_AF_PACKET &&
 		intf.GetType() != interfaces.Interface_DPDK &&
 		intf.GetType() != interfaces.Interface_BOND_INTERFACE {
-		if err = d.ifHandler.SetInterfaceMac(ifIdx, intf.GetPhysAddress()); err != nil {
+		if err = d.ifHandler.SetInterfaceMac(ifIdx, physAddress); err != nil {
 			err = errors.Errorf("failed to set MAC address %s to interface %s: %v",
-				intf.GetPhysAddress(), intf.Name, err)
+				physAddress, intf.Name, err)
 			d.log.Error(err)
 			return nil, err
 		}
@@ -221,6 +236,33 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 		}
 	}
 
+	// enable IP directed broadcast
+	if intf.DirectedBroadcast {
+		if err = d.ifHandler.SetInterfaceDirectedBroadcast(ifIdx, true); err != nil {
+			err = errors.Errorf("failed to enable IP directed broadcast on interface %s: %v", intf.Name, err)
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+
+	// enable IPv6 (even without IPv6 addresses)
+	if intf.Ipv6Enabled {
+		if err = d.ifHandler.SetInterfaceIP6Enable(ifIdx, true); err != nil {
+			err = errors.Errorf("failed to enable IPv6 on interface %s: %v", intf.Name, err)
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+
+	// enable collection of detailed interface counters
+	if intf.DetailedStats {
+		if err = d.ifHandler.SetInterfaceDetailedStats(ifIdx, true); err != nil {
+			err = errors.Errorf("failed to enable detailed stats on interface %s: %v", intf.Name, err)
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+
 	// set vlan tag rewrite
 	if intf.Type == interfaces.Interface_SUB_INTERFACE && intf.GetSub().TagRwOption != interfaces.SubInterface_DISABLED {
 		if err := d.ifHandler.SetVLanTagRewrite(ifIdx, intf.GetSub()); err != nil {
@@ -238,10 +280,13 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 		}
 	}
 
+	d.recordAppliedAttrs(intf, ifIdx)
+
 	// fill the metadata
 	metadata = &ifaceidx.IfaceMetadata{
 		SwIfIndex:     ifIdx,
 		Vrf:           intf.Vrf,
+		VrfIPv6:       intf.GetVrfForIpv6(),
 		IPAddresses:   intf.GetIpAddresses(),
 		TAPHostIfName: tapHostIfName,
 	}
@@ -307,6 +352,12 @@ func (d *InterfaceDescriptor) Delete(key string, intf *interfaces.Interface, met
 		return err
 	}
 
+	// return MAC address back to the pool
+	if intf.GetPhysAddress() == interfaces.PhysAddressFromPool {
+		d.macPool.release(intf.Name)
+	}
+
+	delete(d.appliedAttrs, intf.Name)
 	return nil
 }
 
@@ -339,13 +390,24 @@ func (d *InterfaceDescriptor) Update(key string, oldIntf, newIntf *interfaces.In
 		oldIntf.Type != interfaces.Interface_AF_PACKET &&
 		oldIntf.Type != interfaces.Interface_DPDK &&
 		oldIntf.Type != interfaces.Interface_BOND_INTERFACE {
-		if err := d.ifHandler.SetInterfaceMac(ifIdx, newIntf.PhysAddress); err != nil {
+		physAddress := newIntf.PhysAddress
+		if physAddress == interfaces.PhysAddressFromPool {
+			if physAddress, err = d.macPool.allocate(newIntf.Name); err != nil {
+				d.log.Error(err)
+				return oldMetadata, err
+			}
+		}
+		if err := d.ifHandler.SetInterfaceMac(ifIdx, physAddress); err != nil {
 			err = errors.Errorf("setting interface %s MAC address %s failed: %v",
-				newIntf.Name, newIntf.PhysAddress, err)
+				newIntf.Name, physAddress, err)
 			d.log.Error(err)
 			return oldMetadata, err
 		}
 	}
+	if oldIntf.PhysAddress == interfaces.PhysAddressFromPool &&
+		newIntf.PhysAddress != interfaces.PhysAddressFromPool {
+		d.macPool.release(newIntf.Name)
+	}
 
 	// update MTU (except VxLan, IPSec)
 	if ifaceSupportsSetMTU(newIntf) {
@@ -364,6 +426,33 @@ func (d *InterfaceDescriptor) Update(key string, oldIntf, newIntf *interfaces.In
 		}
 	}
 
+	// update IP directed broadcast
+	if newIntf.DirectedBroadcast != oldIntf.DirectedBroadcast {
+		if err := d.ifHandler.SetInterfaceDirectedBroadcast(ifIdx, newIntf.DirectedBroadcast); err != nil {
+			err = errors.Errorf("failed to set IP directed broadcast on interface %s: %v", newIntf.Name, err)
+			d.log.Error(err)
+			return oldMetadata, err
+		}
+	}
+
+	// update IPv6 enablement
+	if newIntf.Ipv6Enabled != oldIntf.Ipv6Enabled {
+		if err := d.ifHandler.SetInterfaceIP6Enable(ifIdx, newIntf.Ipv6Enabled); err != nil {
+			err = errors.Errorf("failed to set IPv6 enablement on interface %s: %v", newIntf.Name, err)
+			d.log.Error(err)
+			return oldMetadata, err
+		}
+	}
+
+	// update collection of detailed interface counters
+	if newIntf.DetailedStats != oldIntf.DetailedStats {
+		if err := d.ifHandler.SetInterfaceDetailedStats(ifIdx, newIntf.DetailedStats); err != nil {
+			err = errors.Errorf("failed to set detailed stats on interface %s: %v", newIntf.Name, err)
+			d.log.Error(err)
+			return oldMetadata, err
+		}
+	}
+
 	// update vlan tag rewrite
 	if newIntf.Type == interfaces.Interface_SUB_INTERFACE {
 		oldSub, newSub := oldIntf.GetSub(), newIntf.GetSub()
@@ -378,9 +467,12 @@ func (d *InterfaceDescriptor) Update(key string, oldIntf, newIntf *interfaces.In
 		}
 	}
 
+	d.recordAppliedAttrs(newIntf, ifIdx)
+
 	// update metadata
 	oldMetadata.IPAddresses = newIntf.IpAddresses
 	oldMetadata.Vrf = newIntf.Vrf
+	oldMetadata.VrfIPv6 = newIntf.GetVrfForIpv6()
 	return oldMetadata, nil
 }
 
@@ -432,6 +524,14 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 		return retrieved, err
 	}
 
+	// re-learn MAC addresses in use, allocations are restored below by correlation
+	if d.macPool != nil {
+		d.macPool.reset()
+		for _, intf := range vppIfs {
+			d.macPool.markUsed(intf.Interface.Name, intf.Interface.PhysAddress)
+		}
+	}
+
 	for ifIdx, intf := range vppIfs {
 		origin := kvs.FromNB
 		if ifIdx == 0 {
@@ -466,6 +566,25 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 			}
 		}
 
+		// attributes that cannot be dumped are reported as last applied by the agent
+		// in this VPP run (unset for interfaces not configured by the agent)
+		appliedAttrs := d.getAppliedAttrs(intf.Interface.Name, ifIdx)
+		intf.Interface.DirectedBroadcast = appliedAttrs.directedBroadcast
+		intf.Interface.DetailedStats = appliedAttrs.detailedStats
+
+		// IPv6 is enabled implicitly with an IPv6 address assigned, explicit
+		// enablement can be told apart only for interfaces without IPv6 addresses
+		intf.Interface.Ipv6Enabled = appliedAttrs.ipv6Enabled
+		if _, hasIPv6 := getIPAddressVersions(intf.Interface.IpAddresses); !hasIPv6 {
+			ipv6Enabled, err := d.ifHandler.GetInterfaceIP6Enabled(intf.Meta.InternalName)
+			if err != nil {
+				d.log.Warnf("failed to dump IPv6 enablement of interface %s: %v",
+					intf.Interface.Name, err)
+			} else {
+				intf.Interface.Ipv6Enabled = ipv6Enabled
+			}
+		}
+
 		// correlate attributes that cannot be dumped
 		if expCfg, hasExpCfg := ifCfg[intf.Interface.Name]; hasExpCfg {
 			if expCfg.Type == interfaces.Interface_TAP && intf.Interface.GetTap() != nil {
@@ -528,6 +647,13 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 				intf.Interface.RxModes = []*interfaces.Interface_RxMode{}
 			}
 
+			// correlate MAC address allocated from the pool
+			if expCfg.GetPhysAddress() == interfaces.PhysAddressFromPool && d.macPool != nil &&
+				d.macPool.contains(intf.Interface.PhysAddress) {
+				d.macPool.reserve(intf.Interface.Name, intf.Interface.PhysAddress)
+				intf.Interface.PhysAddress = interfaces.PhysAddressFromPool
+			}
+
 			// correlate references to allocated IP addresses
 			intf.Interface.IpAddresses = d.addrAlloc.CorrelateRetrievedIPs(
 				expCfg.IpAddresses, intf.Interface.IpAddresses,
@@ -567,6 +693,7 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 		metadata := &ifaceidx.IfaceMetadata{
 			SwIfIndex:     ifIdx,
 			Vrf:           intf.Interface.Vrf,
+			VrfIPv6:       intf.Interface.GetVrfForIpv6(),
 			IPAddresses:   intf.Interface.IpAddresses,
 			TAPHostIfName: tapHostIfName,
 		}
@@ -582,6 +709,51 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 	return retrieved, nil
 }
 
+// appliedIfAttrs are interface attributes applied by the agent, which cannot
+// be dumped from VPP.
+type appliedIfAttrs struct {
+	swIfIndex         uint32
+	directedBroadcast bool
+	ipv6Enabled       bool
+	detailedStats     bool
+}
+
+// checkVPPRun forgets attributes applied to interfaces if VPP has been restarted since.
+func (d *InterfaceDescriptor) checkVPPRun() {
+	if d.vppRun == nil {
+		return
+	}
+	if vppRun := d.vppRun(); vppRun != d.appliedVPPRun {
+		d.appliedAttrs = nil
+		d.appliedVPPRun = vppRun
+	}
+}
+
+// recordAppliedAttrs remembers attributes applied to the given interface, which cannot be dumped.
+func (d *InterfaceDescriptor) recordAppliedAttrs(intf *interfaces.Interface, ifIdx uint32) {
+	d.checkVPPRun()
+	if d.appliedAttrs == nil {
+		d.appliedAttrs = make(map[string]appliedIfAttrs)
+	}
+	d.appliedAttrs[intf.Name] = appliedIfAttrs{
+		swIfIndex:         ifIdx,
+		directedBroadcast: intf.DirectedBroadcast,
+		ipv6Enabled:       intf.Ipv6Enabled,
+		detailedStats:     intf.DetailedStats,
+	}
+}
+
+// getAppliedAttrs returns attributes applied to the given interface in the current
+// VPP run. For interfaces not configured by the agent all attributes are unset.
+func (d *InterfaceDescriptor) getAppliedAttrs(ifName string, ifIdx uint32) appliedIfAttrs {
+	d.checkVPPRun()
+	attrs, applied := d.appliedAttrs[ifName]
+	if !applied || attrs.swIfIndex != ifIdx {
+		return appliedIfAttrs{}
+	}
+	return attrs
+}
+
 func ifaceSupportsSetMTU(intf *interfaces.Interface) bool {
 	switch intf.Type {
 	case interfaces.Interface_VXLAN_TUNNEL,
@@ -0,0 +1,319 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	netalloc_mock "go.ligato.io/vpp-agent/v3/plugins/netalloc/mock"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// attrsIfHandler mocks interface dump and records requests to set interface
+// attributes, other methods of the handler are not used by the tests.
+type attrsIfHandler struct {
+	vppcalls.InterfaceVppAPI
+	ifs               map[uint32]*vppcalls.InterfaceDetails
+	ipv6              map[uint32]bool // IPv6 enablement by interface index
+	directedBroadcast []bool
+	ipv6Enable        []bool
+	detailedStats     []bool
+}
+
+func (h *attrsIfHandler) AddLoopbackInterface(ifName string) (uint32, error) {
+	ifIdx := uint32(len(h.ifs) + 1)
+	h.ifs[ifIdx] = &vppcalls.InterfaceDetails{
+		Interface: &interfaces.Interface{
+			Name: ifName,
+			Type: interfaces.Interface_SOFTWARE_LOOPBACK,
+		},
+		Meta: &vppcalls.InterfaceMeta{SwIfIndex: ifIdx, InternalName: fmt.Sprintf("loop%d", ifIdx)},
+	}
+	return ifIdx, nil
+}
+
+func (h *attrsIfHandler) DumpMemifSocketDetails(ctx context.Context) (map[string]uint32, error) {
+	return map[string]uint32{}, nil
+}
+
+func (h *attrsIfHandler) DumpInterfaces(ctx context.Context) (map[uint32]*vppcalls.InterfaceDetails, error) {
+	return h.ifs, nil
+}
+
+func (h *attrsIfHandler) SetInterfaceDirectedBroadcast(ifIdx uint32, enable bool) error {
+	h.directedBroadcast = append(h.directedBroadcast, enable)
+	return nil
+}
+
+func (h *attrsIfHandler) SetInterfaceIP6Enable(ifIdx uint32, enable bool) error {
+	h.ipv6Enable = append(h.ipv6Enable, enable)
+	h.ipv6[ifIdx] = enable
+	return nil
+}
+
+func (h *attrsIfHandler) GetInterfaceIP6Enabled(internalName string) (bool, error) {
+	for ifIdx, intf := range h.ifs {
+		if intf.Meta.InternalName == internalName {
+			return h.ipv6[ifIdx], nil
+		}
+	}
+	return false, errors.New("interface not found")
+}
+
+func (h *attrsIfHandler) SetInterfaceDetailedStats(ifIdx uint32, enable bool) error {
+	h.detailedStats = append(h.detailedStats, enable)
+	return nil
+}
+
+func TestTapLinkChange(t *testing.T) {
+	tap := func(link *interfaces.TapLink) *interfaces.Interface {
+		return &interfaces.Interface{
+			Name:    "tap1",
+			Type:    interfaces.Interface_TAP,
+			Enabled: true,
+			Link:    &interfaces.Interface_Tap{Tap: link},
+		}
+	}
+	tests := []struct {
+		name             string
+		oldIntf, newIntf *interfaces.Interface
+		expectRecreate   bool
+	}{
+		{
+			name:    "no change",
+			oldIntf: tap(&interfaces.TapLink{Version: 2, EnableGso: true}),
+			newIntf: tap(&interfaces.TapLink{Version: 2, EnableGso: true}),
+		},
+		{
+			name:           "enable GSO",
+			oldIntf:        tap(&interfaces.TapLink{Version: 2}),
+			newIntf:        tap(&interfaces.TapLink{Version: 2, EnableGso: true}),
+			expectRecreate: true,
+		},
+		{
+			name:           "disable GSO",
+			oldIntf:        tap(&interfaces.TapLink{Version: 2, EnableGso: true}),
+			newIntf:        tap(&interfaces.TapLink{Version: 2}),
+			expectRecreate: true,
+		},
+		{
+			name:           "enable checksum offload",
+			oldIntf:        tap(&interfaces.TapLink{Version: 2}),
+			newIntf:        tap(&interfaces.TapLink{Version: 2, EnableCsumOffload: true}),
+			expectRecreate: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			d := &InterfaceDescriptor{}
+			key := interfaces.InterfaceKey(test.newIntf.Name)
+			Expect(d.EquivalentInterfaces(key, test.oldIntf, test.newIntf)).To(Equal(!test.expectRecreate))
+			Expect(d.UpdateWithRecreate(key, test.oldIntf, test.newIntf, nil)).To(Equal(test.expectRecreate))
+		})
+	}
+}
+
+func TestDetailedStatsUpdatedAfterRestart(t *testing.T) {
+	RegisterTestingT(t)
+	handler := &attrsIfHandler{
+		ifs: map[uint32]*vppcalls.InterfaceDetails{
+			1: {
+				Interface: &interfaces.Interface{
+					Name:    "eth0",
+					Type:    interfaces.Interface_DPDK,
+					Enabled: true,
+				},
+				Meta: &vppcalls.InterfaceMeta{SwIfIndex: 1},
+			},
+		},
+	}
+	intf := &interfaces.Interface{
+		Name:          "eth0",
+		Type:          interfaces.Interface_DPDK,
+		Enabled:       true,
+		DetailedStats: true,
+	}
+	key := interfaces.InterfaceKey(intf.Name)
+	correlate := []adapter.InterfaceKVWithMetadata{{Key: key, Value: intf}}
+
+	// startup resync of a restarted agent - the setting is not known to be applied
+	d := &InterfaceDescriptor{
+		log:       logrus.DefaultLogger(),
+		ifHandler: handler,
+		addrAlloc: netalloc_mock.NewMockNetAlloc(),
+	}
+	retrieved, err := d.Retrieve(correlate)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(HaveLen(1))
+	Expect(retrieved[0].Value.DetailedStats).To(BeFalse())
+	Expect(handler.detailedStats).To(BeEmpty())
+
+	// the scheduler re-applies the setting via Update
+	Expect(d.EquivalentInterfaces(key, retrieved[0].Value, intf)).To(BeFalse())
+	_, err = d.Update(key, retrieved[0].Value, intf, retrieved[0].Metadata)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(handler.detailedStats).To(Equal([]bool{true}))
+
+	// subsequent resync - the applied setting is retrieved
+	retrieved, err = d.Retrieve(correlate)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(HaveLen(1))
+	Expect(retrieved[0].Value.DetailedStats).To(BeTrue())
+	Expect(d.EquivalentInterfaces(key, retrieved[0].Value, intf)).To(BeTrue())
+}
+
+func TestRetrieveDirectedBroadcast(t *testing.T) {
+	RegisterTestingT(t)
+	handler := &attrsIfHandler{
+		ifs: map[uint32]*vppcalls.InterfaceDetails{
+			1: {
+				Interface: &interfaces.Interface{
+					Name:    "eth0",
+					Type:    interfaces.Interface_DPDK,
+					Enabled: true,
+				},
+				Meta: &vppcalls.InterfaceMeta{SwIfIndex: 1},
+			},
+		},
+	}
+	vppRun := "run1"
+	d := &InterfaceDescriptor{
+		log:       logrus.DefaultLogger(),
+		ifHandler: handler,
+		addrAlloc: netalloc_mock.NewMockNetAlloc(),
+	}
+	d.SetVPPRunIdentifier(func() string { return vppRun })
+	intf := &interfaces.Interface{
+		Name:              "eth0",
+		Type:              interfaces.Interface_DPDK,
+		Enabled:           true,
+		DirectedBroadcast: true,
+	}
+	key := interfaces.InterfaceKey(intf.Name)
+	correlate := []adapter.InterfaceKVWithMetadata{{Key: key, Value: intf}}
+
+	// not applied by the agent yet - reported as disabled to get updated
+	retrieved, err := d.Retrieve(correlate)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(HaveLen(1))
+	Expect(retrieved[0].Value.DirectedBroadcast).To(BeFalse())
+	Expect(d.EquivalentInterfaces(key, retrieved[0].Value, intf)).To(BeFalse())
+	_, err = d.Update(key, retrieved[0].Value, intf, retrieved[0].Metadata)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(handler.directedBroadcast).To(Equal([]bool{true}))
+
+	// applied in this VPP run
+	retrieved, err = d.Retrieve(correlate)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(HaveLen(1))
+	Expect(retrieved[0].Value.DirectedBroadcast).To(BeTrue())
+
+	// VPP restarted
+	vppRun = "run2"
+	retrieved, err = d.Retrieve(correlate)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(HaveLen(1))
+	Expect(retrieved[0].Value.DirectedBroadcast).To(BeFalse())
+}
+
+func TestIPv6EnabledWithoutAddress(t *testing.T) {
+	RegisterTestingT(t)
+	handler := &attrsIfHandler{
+		ifs:  map[uint32]*vppcalls.InterfaceDetails{},
+		ipv6: map[uint32]bool{},
+	}
+	newDescriptor := func() *InterfaceDescriptor {
+		return &InterfaceDescriptor{
+			log:       logrus.DefaultLogger(),
+			ifHandler: handler,
+			addrAlloc: netalloc_mock.NewMockNetAlloc(),
+		}
+	}
+	loop := func(ipv6Enabled bool) *interfaces.Interface {
+		return &interfaces.Interface{
+			Name:        "loop1",
+			Type:        interfaces.Interface_SOFTWARE_LOOPBACK,
+			Ipv6Enabled: ipv6Enabled,
+		}
+	}
+	key := interfaces.InterfaceKey("loop1")
+	retrieve := func(d *InterfaceDescriptor, intf *interfaces.Interface) *interfaces.Interface {
+		retrieved, err := d.Retrieve([]adapter.InterfaceKVWithMetadata{{Key: key, Value: intf}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retrieved).To(HaveLen(1))
+		return retrieved[0].Value
+	}
+	d := newDescriptor()
+
+	// create
+	metadata, err := d.Create(key, loop(true))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(handler.ipv6Enable).To(Equal([]bool{true}))
+
+	// retrieve - the enablement is dumped (even by a restarted agent)
+	Expect(retrieve(d, loop(true)).Ipv6Enabled).To(BeTrue())
+	Expect(retrieve(newDescriptor(), loop(true)).Ipv6Enabled).To(BeTrue())
+
+	// update
+	_, err = d.Update(key, loop(true), loop(false), metadata)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(handler.ipv6Enable).To(Equal([]bool{true, false}))
+	Expect(retrieve(d, loop(false)).Ipv6Enabled).To(BeFalse())
+
+	// enabled outside of the agent
+	handler.ipv6[metadata.SwIfIndex] = true
+	retrieved := retrieve(d, loop(false))
+	Expect(retrieved.Ipv6Enabled).To(BeTrue())
+	Expect(d.EquivalentInterfaces(key, retrieved, loop(false))).To(BeFalse())
+}
+
+// failingIfHandler fails to create any loopback interface.
+type failingIfHandler struct {
+	vppcalls.InterfaceVppAPI
+}
+
+func (h *failingIfHandler) AddLoopbackInterface(ifName string) (uint32, error) {
+	return 0, errors.New("loopback creation failed")
+}
+
+func TestCreateReleasesMacOnFailure(t *testing.T) {
+	RegisterTestingT(t)
+	pool, err := newMacPool("02:fe:00")
+	Expect(err).ToNot(HaveOccurred())
+	d := &InterfaceDescriptor{
+		log:       logrus.DefaultLogger(),
+		ifHandler: &failingIfHandler{},
+		macPool:   pool,
+	}
+	intf := &interfaces.Interface{
+		Name:        "loop1",
+		Type:        interfaces.Interface_SOFTWARE_LOOPBACK,
+		PhysAddress: interfaces.PhysAddressFromPool,
+	}
+
+	_, err = d.Create(interfaces.InterfaceKey(intf.Name), intf)
+	Expect(err).To(HaveOccurred())
+	Expect(pool.allocated).To(BeEmpty())
+	Expect(pool.inUse).To(BeEmpty())
+}
@@ -78,22 +78,22 @@ func (d *InterfaceVrfDescriptor) IsInterfaceVrfKey(key string) bool {
 
 // Create puts interface into the given VRF table.
 func (d *InterfaceVrfDescriptor) Create(key string, emptyVal proto.Message) (metadata kvs.Metadata, err error) {
-	swIfIndex, vrf, inheritedVrf, ipv4, ipv6, err := d.getParametersFromKey(key)
+	swIfIndex, vrfV4, vrfV6, inheritedVrf, ipv4, ipv6, err := d.getParametersFromKey(key)
 	if err != nil {
 		return nil, err
 	}
 
 	// unnumbered interfaces (inherited VRF) should not be explicitly set to VRF=0 (probably bug in VPP)
 
-	if (!inheritedVrf || vrf > 0) && ipv4 {
-		err = d.ifHandler.SetInterfaceVrf(swIfIndex, uint32(vrf))
+	if (!inheritedVrf || vrfV4 > 0) && ipv4 {
+		err = d.ifHandler.SetInterfaceVrf(swIfIndex, vrfV4)
 		if err != nil {
 			d.log.Error(err)
 			return nil, err
 		}
 	}
-	if (!inheritedVrf || vrf > 0) && ipv6 {
-		err = d.ifHandler.SetInterfaceVrfIPv6(swIfIndex, uint32(vrf))
+	if (!inheritedVrf || vrfV6 > 0) && ipv6 {
+		err = d.ifHandler.SetInterfaceVrfIPv6(swIfIndex, vrfV6)
 		if err != nil {
 			d.log.Error(err)
 			return nil, err
@@ -104,19 +104,19 @@ func (d *InterfaceVrfDescriptor) Create(key string, emptyVal proto.Message) (met
 
 // Delete removes interface from the given VRF table.
 func (d *InterfaceVrfDescriptor) Delete(key string, emptyVal proto.Message, metadata kvs.Metadata) (err error) {
-	swIfIndex, vrf, inheritedVrf, ipv4, ipv6, err := d.getParametersFromKey(key)
+	swIfIndex, vrfV4, vrfV6, inheritedVrf, ipv4, ipv6, err := d.getParametersFromKey(key)
 	if err != nil {
 		return err
 	}
 
-	if !inheritedVrf && vrf > 0 && ipv4 {
+	if !inheritedVrf && vrfV4 > 0 && ipv4 {
 		err = d.ifHandler.SetInterfaceVrf(swIfIndex, uint32(0))
 		if err != nil {
 			d.log.Error(err)
 			return err
 		}
 	}
-	if !inheritedVrf && vrf > 0 && ipv6 {
+	if !inheritedVrf && vrfV6 > 0 && ipv6 {
 		err = d.ifHandler.SetInterfaceVrfIPv6(swIfIndex, uint32(0))
 		if err != nil {
 			d.log.Error(err)
@@ -155,7 +155,10 @@ func (d *InterfaceVrfDescriptor) Dependencies(key string, emptyVal proto.Message
 	}
 }
 
-func (d *InterfaceVrfDescriptor) getParametersFromKey(key string) (swIfIndex, vrf uint32,
+// getParametersFromKey returns the interface index and the VRF tables for IPv4
+// and IPv6. For keys with explicit VRF both tables are equal, inherited VRF
+// follows the (possibly different) tables of the numbered interface.
+func (d *InterfaceVrfDescriptor) getParametersFromKey(key string) (swIfIndex, vrfV4, vrfV6 uint32,
 	inheritedVrf bool, ipv4, ipv6 bool, err error) {
 
 	var (
@@ -165,7 +168,9 @@ func (d *InterfaceVrfDescriptor) getParametersFromKey(key string) (swIfIndex, vr
 	)
 
 	iface, vrfTableID, ipv4, ipv6, isIfaceVrfKey = interfaces.ParseInterfaceVrfKey(key)
-	if !isIfaceVrfKey {
+	if isIfaceVrfKey {
+		vrfV4, vrfV6 = uint32(vrfTableID), uint32(vrfTableID)
+	} else {
 		iface, fromIface, _ = interfaces.ParseInterfaceInheritedVrfKey(key)
 		fromIfaceMeta, found := d.ifIndex.LookupByName(fromIface)
 		if !found {
@@ -173,7 +178,7 @@ func (d *InterfaceVrfDescriptor) getParametersFromKey(key string) (swIfIndex, vr
 			d.log.Error(err)
 			return
 		}
-		vrfTableID = int(fromIfaceMeta.Vrf)
+		vrfV4, vrfV6 = fromIfaceMeta.Vrf, fromIfaceMeta.VrfIPv6
 		ipv4, ipv6 = getIPAddressVersions(fromIfaceMeta.IPAddresses)
 		inheritedVrf = true
 	}
@@ -186,6 +191,5 @@ func (d *InterfaceVrfDescriptor) getParametersFromKey(key string) (swIfIndex, vr
 	}
 
 	swIfIndex = ifMeta.SwIfIndex
-	vrf = uint32(vrfTableID)
 	return
 }
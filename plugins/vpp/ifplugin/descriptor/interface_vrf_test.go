@@ -0,0 +1,207 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	netalloc_mock "go.ligato.io/vpp-agent/v3/plugins/netalloc/mock"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	netalloc_api "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestDerivedVrfKeys(t *testing.T) {
+	tests := []struct {
+		name         string
+		iface        *interfaces.Interface
+		expectedKeys []string
+	}{
+		{
+			name: "no addresses",
+			iface: &interfaces.Interface{
+				Name: "if1",
+				Vrf:  10,
+			},
+		},
+		{
+			name: "IPv4 only",
+			iface: &interfaces.Interface{
+				Name:        "if1",
+				Vrf:         10,
+				VrfIpv6:     20,
+				IpAddresses: []string{"10.0.0.1/24"},
+			},
+			expectedKeys: []string{interfaces.InterfaceVrfKey("if1", 10, true, false)},
+		},
+		{
+			name: "IPv6 only with override",
+			iface: &interfaces.Interface{
+				Name:        "if1",
+				Vrf:         10,
+				VrfIpv6:     20,
+				IpAddresses: []string{"2001:db8::1/64"},
+			},
+			expectedKeys: []string{interfaces.InterfaceVrfKey("if1", 20, false, true)},
+		},
+		{
+			name: "dual-stack in the same table",
+			iface: &interfaces.Interface{
+				Name:        "if1",
+				Vrf:         10,
+				IpAddresses: []string{"10.0.0.1/24", "2001:db8::1/64"},
+			},
+			expectedKeys: []string{interfaces.InterfaceVrfKey("if1", 10, true, true)},
+		},
+		{
+			name: "dual-stack with the same table set explicitly",
+			iface: &interfaces.Interface{
+				Name:        "if1",
+				Vrf:         10,
+				VrfIpv6:     10,
+				IpAddresses: []string{"10.0.0.1/24", "2001:db8::1/64"},
+			},
+			expectedKeys: []string{interfaces.InterfaceVrfKey("if1", 10, true, true)},
+		},
+		{
+			name: "dual-stack in different tables",
+			iface: &interfaces.Interface{
+				Name:        "if1",
+				Vrf:         10,
+				VrfIpv6:     20,
+				IpAddresses: []string{"10.0.0.1/24", "2001:db8::1/64"},
+			},
+			expectedKeys: []string{
+				interfaces.InterfaceVrfKey("if1", 10, true, false),
+				interfaces.InterfaceVrfKey("if1", 20, false, true),
+			},
+		},
+		{
+			name: "unnumbered",
+			iface: &interfaces.Interface{
+				Name:       "if1",
+				Vrf:        10,
+				VrfIpv6:    20,
+				Unnumbered: &interfaces.Interface_Unnumbered{InterfaceWithIp: "if2"},
+			},
+			expectedKeys: []string{interfaces.InterfaceInheritedVrfKey("if1", "if2")},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			d := &InterfaceDescriptor{}
+			var vrfKeys []string
+			for _, kv := range d.DerivedValues(interfaces.InterfaceKey(test.iface.Name), test.iface) {
+				if _, _, _, _, isVrfKey := interfaces.ParseInterfaceVrfKey(kv.Key); isVrfKey {
+					vrfKeys = append(vrfKeys, kv.Key)
+				}
+				if _, _, isInherited := interfaces.ParseInterfaceInheritedVrfKey(kv.Key); isInherited {
+					vrfKeys = append(vrfKeys, kv.Key)
+				}
+			}
+			if len(test.expectedKeys) == 0 {
+				Expect(vrfKeys).To(BeEmpty())
+			} else {
+				Expect(vrfKeys).To(ConsistOf(test.expectedKeys))
+			}
+		})
+	}
+}
+
+func TestInterfaceAddressVrfDependency(t *testing.T) {
+	v4VrfKey := interfaces.InterfaceVrfKey("if1", 10, true, false)
+	v6VrfKey := interfaces.InterfaceVrfKey("if1", 20, false, true)
+	dualVrfKey := interfaces.InterfaceVrfKey("if1", 10, true, true)
+	inheritedVrfKey := interfaces.InterfaceInheritedVrfKey("if1", "if2")
+
+	tests := []struct {
+		name        string
+		address     string
+		satisfiedBy []string
+		blockedBy   []string
+	}{
+		{
+			name:        "IPv4 address",
+			address:     "10.0.0.1/24",
+			satisfiedBy: []string{v4VrfKey, dualVrfKey, inheritedVrfKey},
+			blockedBy:   []string{v6VrfKey},
+		},
+		{
+			name:        "IPv6 address",
+			address:     "2001:db8::1/64",
+			satisfiedBy: []string{v6VrfKey, dualVrfKey, inheritedVrfKey},
+			blockedBy:   []string{v4VrfKey},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			d := &InterfaceAddressDescriptor{addrAlloc: netalloc_mock.NewMockNetAlloc()}
+			addrKey := interfaces.InterfaceAddressKey("if1", test.address, netalloc_api.IPAddressSource_STATIC)
+			deps := d.Dependencies(addrKey, nil)
+			Expect(deps).ToNot(BeEmpty())
+			vrfDep := deps[0]
+			Expect(vrfDep.Label).To(Equal(interfaceInVrfDep))
+			Expect(vrfDep.AnyOf.KeyPrefixes).To(ConsistOf(interfaces.InterfaceVrfKeyPrefix("if1")))
+			Expect(vrfDep.AnyOf.KeySelector).ToNot(BeNil())
+			for _, key := range test.satisfiedBy {
+				Expect(vrfDep.AnyOf.KeySelector(key)).To(BeTrue(), key)
+			}
+			for _, key := range test.blockedBy {
+				Expect(vrfDep.AnyOf.KeySelector(key)).To(BeFalse(), key)
+			}
+		})
+	}
+}
+
+func TestInheritedVrfParameters(t *testing.T) {
+	RegisterTestingT(t)
+	ifIndex := ifaceidx.NewIfaceIndex(logrus.DefaultLogger(), "test-iface-index")
+	ifIndex.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndex.Put("if2", &ifaceidx.IfaceMetadata{
+		SwIfIndex:   2,
+		Vrf:         10,
+		VrfIPv6:     20,
+		IPAddresses: []string{"10.0.0.1/24", "2001:db8::1/64"},
+	})
+	d := &InterfaceVrfDescriptor{
+		log:     logrus.DefaultLogger(),
+		ifIndex: ifIndex,
+	}
+
+	swIfIndex, vrfV4, vrfV6, inherited, ipv4, ipv6, err := d.getParametersFromKey(
+		interfaces.InterfaceInheritedVrfKey("if1", "if2"))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(swIfIndex).To(Equal(uint32(1)))
+	Expect(vrfV4).To(Equal(uint32(10)))
+	Expect(vrfV6).To(Equal(uint32(20)))
+	Expect(inherited).To(BeTrue())
+	Expect(ipv4).To(BeTrue())
+	Expect(ipv6).To(BeTrue())
+
+	swIfIndex, vrfV4, vrfV6, inherited, ipv4, ipv6, err = d.getParametersFromKey(
+		interfaces.InterfaceVrfKey("if1", 30, false, true))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(swIfIndex).To(Equal(uint32(1)))
+	Expect(vrfV4).To(Equal(uint32(30)))
+	Expect(vrfV6).To(Equal(uint32(30)))
+	Expect(inherited).To(BeFalse())
+	Expect(ipv4).To(BeFalse())
+	Expect(ipv6).To(BeTrue())
+}
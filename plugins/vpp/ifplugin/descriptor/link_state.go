@@ -43,6 +43,10 @@ type LinkStateDescriptor struct {
 
 	linkStatesMx sync.Mutex
 	linkStates   map[string]bool // interface name -> link is up
+
+	// while paused, only the last state of each interface is kept
+	paused  bool
+	pending map[string]*interfaces.InterfaceNotification
 }
 
 // NewLinkStateDescriptor creates a new instance of the Link-State descriptor.
@@ -109,12 +113,54 @@ func (w *LinkStateDescriptor) Retrieve(correlate []kvs.KVWithMetadata) (values [
 }
 
 // UpdateLinkState notifies scheduler about a change in the link state of an interface.
+// While notifications are paused, the change is only recorded and will be
+// delivered by Resume.
 func (w *LinkStateDescriptor) UpdateLinkState(ifaceState *interfaces.InterfaceNotification) {
 	w.linkStatesMx.Lock()
 	defer w.linkStatesMx.Unlock()
 
+	if w.paused {
+		w.pending[ifaceState.State.Name] = ifaceState
+		return
+	}
+	w.pushNotifications(w.linkStateChange(ifaceState))
+}
+
+// Pause stops notifying scheduler about link state changes until Resume is called.
+// Changes received in the meantime are coalesced per interface.
+// Resync (explicit or periodic) is not affected.
+func (w *LinkStateDescriptor) Pause() {
+	w.linkStatesMx.Lock()
+	defer w.linkStatesMx.Unlock()
+
+	if w.paused {
+		return
+	}
+	w.paused = true
+	w.pending = make(map[string]*interfaces.InterfaceNotification)
+}
+
+// Resume re-enables notifications about link state changes and sends
+// the changes coalesced during the pause as a single notification.
+func (w *LinkStateDescriptor) Resume() {
+	w.linkStatesMx.Lock()
+	defer w.linkStatesMx.Unlock()
+
+	if !w.paused {
+		return
+	}
 	var notifs []kvs.KVWithMetadata
+	for _, ifaceState := range w.pending {
+		notifs = append(notifs, w.linkStateChange(ifaceState)...)
+	}
+	w.paused = false
+	w.pending = nil
+	w.pushNotifications(notifs)
+}
 
+// linkStateChange returns notifications needed to reflect the given interface
+// state and updates the cached link states. Expects linkStatesMx to be locked.
+func (w *LinkStateDescriptor) linkStateChange(ifaceState *interfaces.InterfaceNotification) (notifs []kvs.KVWithMetadata) {
 	operStatus := ifaceState.State.OperStatus
 	ifaceName := ifaceState.State.Name
 	linkWasUp, hadLinkState := w.linkStates[ifaceName]
@@ -144,6 +190,10 @@ func (w *LinkStateDescriptor) UpdateLinkState(ifaceState *interfaces.InterfaceNo
 		w.linkStates[ifaceName] = linkIsUp
 	}
 
+	return notifs
+}
+
+func (w *LinkStateDescriptor) pushNotifications(notifs []kvs.KVWithMetadata) {
 	if len(notifs) != 0 {
 		err := w.kvscheduler.PushSBNotification(notifs...)
 		if err != nil {
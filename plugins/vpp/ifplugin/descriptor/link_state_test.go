@@ -0,0 +1,137 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// notifScheduler records SB notifications, other methods of the scheduler
+// are not used by the tests.
+type notifScheduler struct {
+	kvs.KVScheduler
+	notifs [][]kvs.KVWithMetadata // one item per PushSBNotification call
+}
+
+func (s *notifScheduler) PushSBNotification(notif ...kvs.KVWithMetadata) error {
+	s.notifs = append(s.notifs, notif)
+	return nil
+}
+
+// linkStateIfHandler mocks dump of interface states, other methods of the handler
+// are not used by the tests.
+type linkStateIfHandler struct {
+	vppcalls.InterfaceVppAPI
+	states map[uint32]*vppcalls.InterfaceState
+}
+
+func (h *linkStateIfHandler) DumpInterfaceStates(ifIdxs ...uint32) (map[uint32]*vppcalls.InterfaceState, error) {
+	return h.states, nil
+}
+
+func (h *linkStateIfHandler) setLinkState(ifIdx uint32, linkState interfaces.InterfaceState_Status) {
+	h.states[ifIdx] = &vppcalls.InterfaceState{SwIfIndex: ifIdx, LinkState: linkState}
+}
+
+func linkStateTestSetup(t *testing.T) (*LinkStateDescriptor, *notifScheduler, *linkStateIfHandler) {
+	RegisterTestingT(t)
+	ifIndex := ifaceidx.NewIfaceIndex(logrus.DefaultLogger(), "test-iface-index")
+	ifIndex.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndex.Put("if2", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+	scheduler := &notifScheduler{}
+	handler := &linkStateIfHandler{states: map[uint32]*vppcalls.InterfaceState{}}
+	handler.setLinkState(1, interfaces.InterfaceState_UP)
+	handler.setLinkState(2, interfaces.InterfaceState_UP)
+	d := &LinkStateDescriptor{
+		log:          logrus.DefaultLogger(),
+		kvscheduler:  scheduler,
+		ifaceHandler: handler,
+		ifaceIdx:     ifIndex,
+		linkStates:   make(map[string]bool),
+	}
+	_, err := d.Retrieve(nil)
+	Expect(err).ToNot(HaveOccurred())
+	return d, scheduler, handler
+}
+
+func linkStateNotif(ifName string, operStatus interfaces.InterfaceState_Status) *interfaces.InterfaceNotification {
+	return &interfaces.InterfaceNotification{
+		Type:  interfaces.InterfaceNotification_UPDOWN,
+		State: &interfaces.InterfaceState{Name: ifName, OperStatus: operStatus},
+	}
+}
+
+func notifKeys(notifs []kvs.KVWithMetadata) (keys []string) {
+	for _, notif := range notifs {
+		keys = append(keys, notif.Key)
+	}
+	return keys
+}
+
+func TestLinkStatePauseCoalescing(t *testing.T) {
+	d, scheduler, _ := linkStateTestSetup(t)
+
+	d.Pause()
+	// UP -> DOWN -> UP
+	d.UpdateLinkState(linkStateNotif("if1", interfaces.InterfaceState_DOWN))
+	d.UpdateLinkState(linkStateNotif("if1", interfaces.InterfaceState_UP))
+	// UP -> DOWN -> DOWN
+	d.UpdateLinkState(linkStateNotif("if2", interfaces.InterfaceState_DOWN))
+	d.UpdateLinkState(linkStateNotif("if2", interfaces.InterfaceState_DOWN))
+	Expect(scheduler.notifs).To(BeEmpty())
+
+	d.Resume()
+	Expect(scheduler.notifs).To(HaveLen(1))
+	Expect(notifKeys(scheduler.notifs[0])).To(ConsistOf(
+		interfaces.LinkStateKey("if2", true),
+		interfaces.LinkStateKey("if2", false),
+	))
+
+	// notifications are no longer delayed
+	d.UpdateLinkState(linkStateNotif("if1", interfaces.InterfaceState_DOWN))
+	Expect(scheduler.notifs).To(HaveLen(2))
+	Expect(notifKeys(scheduler.notifs[1])).To(ConsistOf(
+		interfaces.LinkStateKey("if1", true),
+		interfaces.LinkStateKey("if1", false),
+	))
+}
+
+func TestLinkStateRetrieveWhilePaused(t *testing.T) {
+	d, scheduler, handler := linkStateTestSetup(t)
+
+	d.Pause()
+	d.UpdateLinkState(linkStateNotif("if2", interfaces.InterfaceState_DOWN))
+	handler.setLinkState(2, interfaces.InterfaceState_DOWN)
+
+	values, err := d.Retrieve(nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(notifKeys(values)).To(ConsistOf(
+		interfaces.LinkStateKey("if1", true),
+		interfaces.LinkStateKey("if2", false),
+	))
+	Expect(scheduler.notifs).To(BeEmpty())
+
+	// the change was already reflected by the resync
+	d.Resume()
+	Expect(scheduler.notifs).To(BeEmpty())
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// number of addresses available under a single OUI
+const macPoolSize = 1 << 24
+
+// macPool allocates MAC addresses from the range given by a configured OUI
+// (the first three octets). Allocations are keyed by the interface logical
+// name, therefore an interface keeps its address as long as it is configured.
+type macPool struct {
+	oui       net.HardwareAddr
+	next      uint32
+	allocated map[string]string // interface name -> MAC
+	inUse     map[string]string // MAC -> interface name (allocated or retrieved)
+}
+
+// newMacPool creates MAC pool for the given OUI (e.g. "02:fe:00").
+func newMacPool(oui string) (*macPool, error) {
+	ouiAddr, err := net.ParseMAC(oui + ":00:00:00")
+	if err != nil {
+		return nil, errors.Errorf("invalid MAC pool OUI %q: %v", oui, err)
+	}
+	if ouiAddr[0]&0x01 == 0x01 {
+		return nil, errors.Errorf("invalid MAC pool OUI %q: multicast address", oui)
+	}
+	pool := &macPool{oui: ouiAddr[:3]}
+	pool.reset()
+	return pool, nil
+}
+
+// reset forgets all allocations and addresses in use.
+func (p *macPool) reset() {
+	p.next = 1
+	p.allocated = make(map[string]string)
+	p.inUse = make(map[string]string)
+}
+
+// contains returns true if the given MAC address belongs to the pool.
+func (p *macPool) contains(mac string) bool {
+	hwAddr, err := net.ParseMAC(mac)
+	if err != nil || len(hwAddr) != 6 {
+		return false
+	}
+	return hwAddr[0] == p.oui[0] && hwAddr[1] == p.oui[1] && hwAddr[2] == p.oui[2]
+}
+
+// markUsed records MAC address used by an interface (e.g. learned from VPP dump),
+// so that it is never allocated to another interface.
+func (p *macPool) markUsed(ifName, mac string) {
+	if mac == "" {
+		return
+	}
+	p.inUse[strings.ToLower(mac)] = ifName
+}
+
+// reserve records an existing allocation (typically retrieved from VPP).
+func (p *macPool) reserve(ifName, mac string) {
+	mac = strings.ToLower(mac)
+	p.allocated[ifName] = mac
+	p.inUse[mac] = ifName
+}
+
+// allocate returns MAC address allocated for the given interface.
+// The same address is returned for repeated calls until it is released.
+func (p *macPool) allocate(ifName string) (string, error) {
+	if mac, allocated := p.allocated[ifName]; allocated {
+		return mac, nil
+	}
+	for i := 0; i < macPoolSize-1; i++ {
+		suffix := p.next
+		if p.next++; p.next >= macPoolSize {
+			p.next = 1
+		}
+		mac := net.HardwareAddr{p.oui[0], p.oui[1], p.oui[2],
+			byte(suffix >> 16), byte(suffix >> 8), byte(suffix)}.String()
+		if _, used := p.inUse[mac]; !used {
+			p.reserve(ifName, mac)
+			return mac, nil
+		}
+	}
+	return "", errors.Errorf("MAC pool %s is exhausted", p.oui)
+}
+
+// release returns address allocated for the given interface back to the pool.
+func (p *macPool) release(ifName string) {
+	if mac, allocated := p.allocated[ifName]; allocated {
+		delete(p.allocated, ifName)
+		delete(p.inUse, mac)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewMacPool(t *testing.T) {
+	tests := []struct {
+		name      string
+		oui       string
+		expectErr bool
+	}{
+		{name: "valid", oui: "02:fe:00"},
+		{name: "upper-case", oui: "02:FE:0A"},
+		{name: "multicast", oui: "03:fe:00", expectErr: true},
+		{name: "too long", oui: "02:fe:00:01", expectErr: true},
+		{name: "invalid", oui: "xx:fe:00", expectErr: true},
+		{name: "empty", oui: "", expectErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			pool, err := newMacPool(test.oui)
+			if test.expectErr {
+				Expect(err).To(HaveOccurred())
+				Expect(pool).To(BeNil())
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pool).ToNot(BeNil())
+			}
+		})
+	}
+}
+
+func TestMacPool(t *testing.T) {
+	type step struct {
+		op     string // allocate, release, reserve, markUsed, reset
+		ifName string
+		mac    string // input for reserve/markUsed, expected output for allocate
+	}
+	tests := []struct {
+		name  string
+		next  uint32 // overrides the position of the allocator if non-zero
+		steps []step
+	}{
+		{
+			name: "allocate sequentially",
+			steps: []step{
+				{op: "allocate", ifName: "if1", mac: "02:fe:00:00:00:01"},
+				{op: "allocate", ifName: "if2", mac: "02:fe:00:00:00:02"},
+				{op: "allocate", ifName: "if3", mac: "02:fe:00:00:00:03"},
+			},
+		},
+		{
+			name: "repeated allocation is stable",
+			steps: []step{
+				{op: "allocate", ifName: "if1", mac: "02:fe:00:00:00:01"},
+				{op: "allocate", ifName: "if1", mac: "02:fe:00:00:00:01"},
+				{op: "allocate", ifName: "if2", mac: "02:fe:00:00:00:02"},
+			},
+		},
+		{
+			name: "released address is not re-used immediately",
+			steps: []step{
+				{op: "allocate", ifName: "if1", mac: "02:fe:00:00:00:01"},
+				{op: "release", ifName: "if1"},
+				{op: "allocate", ifName: "if2", mac: "02:fe:00:00:00:02"},
+				{op: "allocate", ifName: "if1", mac: "02:fe:00:00:00:03"},
+			},
+		},
+		{
+			name: "reserved address is kept and skipped",
+			steps: []step{
+				{op: "reserve", ifName: "if1", mac: "02:FE:00:00:00:01"},
+				{op: "allocate", ifName: "if1", mac: "02:fe:00:00:00:01"},
+				{op: "allocate", ifName: "if2", mac: "02:fe:00:00:00:02"},
+			},
+		},
+		{
+			name: "skip address used by dumped interface",
+			steps: []step{
+				{op: "markUsed", ifName: "dumped", mac: "02:fe:00:00:00:01"},
+				{op: "markUsed", ifName: "dumped2", mac: "02:fe:00:00:00:02"},
+				{op: "allocate", ifName: "if1", mac: "02:fe:00:00:00:03"},
+			},
+		},
+		{
+			name: "wraparound",
+			next: macPoolSize - 1,
+			steps: []step{
+				{op: "allocate", ifName: "if1", mac: "02:fe:00:ff:ff:ff"},
+				{op: "allocate", ifName: "if2", mac: "02:fe:00:00:00:01"},
+			},
+		},
+		{
+			name: "wraparound skips used addresses",
+			next: macPoolSize - 1,
+			steps: []step{
+				{op: "markUsed", ifName: "dumped", mac: "02:fe:00:ff:ff:ff"},
+				{op: "reserve", ifName: "if0", mac: "02:fe:00:00:00:01"},
+				{op: "allocate", ifName: "if1", mac: "02:fe:00:00:00:02"},
+			},
+		},
+		{
+			name: "reset forgets allocations",
+			steps: []step{
+				{op: "allocate", ifName: "if1", mac: "02:fe:00:00:00:01"},
+				{op: "reset"},
+				{op: "allocate", ifName: "if2", mac: "02:fe:00:00:00:01"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			pool, err := newMacPool("02:fe:00")
+			Expect(err).ToNot(HaveOccurred())
+			if test.next != 0 {
+				pool.next = test.next
+			}
+			for _, s := range test.steps {
+				switch s.op {
+				case "allocate":
+					mac, err := pool.allocate(s.ifName)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(mac).To(Equal(s.mac))
+				case "release":
+					pool.release(s.ifName)
+				case "reserve":
+					pool.reserve(s.ifName, s.mac)
+				case "markUsed":
+					pool.markUsed(s.ifName, s.mac)
+				case "reset":
+					pool.reset()
+				}
+			}
+		})
+	}
+}
+
+func TestMacPoolContains(t *testing.T) {
+	RegisterTestingT(t)
+	pool, err := newMacPool("02:fe:00")
+	Expect(err).ToNot(HaveOccurred())
+
+	Expect(pool.contains("02:fe:00:12:34:56")).To(BeTrue())
+	Expect(pool.contains("02:FE:00:12:34:56")).To(BeTrue())
+	Expect(pool.contains("02:fe:01:12:34:56")).To(BeFalse())
+	Expect(pool.contains("invalid")).To(BeFalse())
+	Expect(pool.contains("")).To(BeFalse())
+}
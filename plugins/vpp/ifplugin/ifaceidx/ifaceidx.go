@@ -61,6 +61,7 @@ type IfaceMetadataIndexRW interface {
 type IfaceMetadata struct {
 	SwIfIndex     uint32
 	Vrf           uint32
+	VrfIPv6       uint32   // VRF table used for IPv6 (equal to Vrf unless overridden)
 	IPAddresses   []string // TODO: update from interfaceAddress descriptor with real IPs (not netalloc links)
 	TAPHostIfName string   /* host interface name set for the Linux-side of the TAP interface; empty for non-TAPs */
 }
@@ -23,6 +23,7 @@ package ifplugin
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -78,17 +79,25 @@ type IfPlugin struct {
 	spanDescriptor      *descriptor.SpanDescriptor
 
 	// from config file
-	defaultMtu uint32
+	defaultMtu    uint32
+	macPoolOUI    string
+	stateDebounce time.Duration
 
 	// state data
 	publishStats     bool
 	publishLock      sync.Mutex
+	statusFilter     []string // guarded by publishLock
 	statusCheckReg   bool
 	watchStatusReg   datasync.WatchRegistration
 	resyncStatusChan chan datasync.ResyncEvent
 	ifStateChan      chan *interfaces.InterfaceNotification
 	ifStateUpdater   *InterfaceStateUpdater
 
+	// subscriptions for interface state events
+	subsLock      sync.Mutex
+	stateSubs     map[*ifStateSubscription]struct{}
+	lastOperState map[string]interfaces.InterfaceState_Status // interface name -> last seen state
+
 	// go routine management
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -161,6 +170,16 @@ func (p *IfPlugin) Init() (err error) {
 		return errors.New("missing index with interface metadata")
 	}
 	ifaceDescrCtx.SetInterfaceIndex(p.intfIndex)
+	ifaceDescrCtx.SetVPPRunIdentifier(func() string {
+		// session info is refreshed with every (re)connect to VPP
+		vppInfo := p.VPP.VPPInfo()
+		return fmt.Sprintf("%d/%d/%v", vppInfo.PID, vppInfo.ClientIdx, vppInfo.Uptime)
+	})
+	if p.macPoolOUI != "" {
+		if err = ifaceDescrCtx.SetMacPool(p.macPoolOUI); err != nil {
+			return err
+		}
+	}
 
 	//   -> descriptors for derived values / notifications
 	var (
@@ -318,6 +337,16 @@ func (p *IfPlugin) GetDHCPIndex() idxmap.NamedMapping {
 	return p.dhcpIndex
 }
 
+// PauseReconciliation stops reconciling on interface link state changes.
+func (p *IfPlugin) PauseReconciliation() {
+	p.linkStateDescriptor.Pause()
+}
+
+// ResumeReconciliation re-enables reconciliation on interface link state changes.
+func (p *IfPlugin) ResumeReconciliation() {
+	p.linkStateDescriptor.Resume()
+}
+
 // SetNotifyService sets notification callback for processing VPP notifications.
 func (p *IfPlugin) SetNotifyService(notify func(notification *vpp.Notification)) {
 	p.PushNotification = notify
@@ -342,10 +371,22 @@ func (p *IfPlugin) fromConfigFile() error {
 			p.Log.Infof("Added status publisher %q from config", pub)
 		}
 		p.Deps.PublishStatistics = publishers
+		if err := validateStatusFilter(config.StatusFilter); err != nil {
+			return err
+		}
+		p.statusFilter = config.StatusFilter
 		if config.MTU != 0 {
 			p.defaultMtu = config.MTU
 			p.Log.Infof("Default MTU set to %v", p.defaultMtu)
 		}
+		if config.MacPool != "" {
+			p.macPoolOUI = config.MacPool
+			p.Log.Infof("MAC pool set to %v", p.macPoolOUI)
+		}
+		if config.StateSubscriptionDebounce != 0 {
+			p.stateDebounce = time.Duration(config.StateSubscriptionDebounce) * time.Millisecond
+			p.Log.Infof("Interface state subscriptions debounced by %v", p.stateDebounce)
+		}
 	}
 	return nil
 }
@@ -33,4 +33,23 @@ type API interface {
 
 	// SetNotifyService allows to pass function for updating interface notifications.
 	SetNotifyService(notify func(notification *vpp.Notification))
+
+	// SubscribeInterfaceState registers channel to receive transitions of the operational
+	// state for the given interfaces (all interfaces if no name is given).
+	// The returned function cancels the subscription.
+	SubscribeInterfaceState(ifNames []string, ch chan<- IfStateEvent) (unsubscribe func())
+
+	// PauseReconciliation stops reconciling on interface link state changes
+	// (e.g. during maintenance). Explicit resync is not affected, neither is
+	// the periodic downstream resync of the scheduler (if enabled), which
+	// still picks up the current link states.
+	PauseReconciliation()
+
+	// ResumeReconciliation re-enables reconciliation on interface link state changes
+	// and catches up with the changes that happened during the pause at once.
+	ResumeReconciliation()
+
+	// SetStatusFilter replaces glob patterns selecting interfaces whose state
+	// is published to the status publishers (all interfaces if empty).
+	SetStatusFilter(patterns []string) error
 }
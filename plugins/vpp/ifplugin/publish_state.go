@@ -1,6 +1,7 @@
 package ifplugin
 
 import (
+	"path"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -70,7 +71,7 @@ func (p *IfPlugin) resyncIfStateEvents(keys []string) error {
 		}
 
 		_, found := p.intfIndex.LookupByName(ifaceName)
-		if !found {
+		if !found || !p.isStatusPublished(ifaceName) {
 			err := p.PublishStatistics.Put(key, nil /*means delete*/)
 			if err != nil {
 				return errors.WithMessagef(err, "publish statistic for key %s failed", key)
@@ -103,7 +104,7 @@ func (p *IfPlugin) publishIfStateEvents() {
 				p.Log.Debugf("Publishing interface state: %+v", ifState)
 			}
 
-			if p.PublishStatistics != nil {
+			if p.PublishStatistics != nil && p.isStatusPublished(ifState.State.Name) {
 				err := p.PublishStatistics.Put(key, ifState.State)
 				if err != nil {
 					if lastPublishErr == nil || lastPublishErr.Error() != err.Error() {
@@ -129,7 +130,7 @@ func (p *IfPlugin) publishIfStateEvents() {
 			}
 
 			// Send interface state data to global agent status
-			if p.statusCheckReg && ifState.State.InternalName != "" {
+			if p.statusCheckReg && ifState.State.InternalName != "" && p.isStatusPublished(ifState.State.Name) {
 				p.StatusCheck.ReportStateChangeWithMeta(p.PluginName, statuscheck.OK, nil, &status.InterfaceStats_Interface{
 					InternalName: ifState.State.InternalName,
 					Index:        ifState.State.IfIndex,
@@ -143,6 +144,7 @@ func (p *IfPlugin) publishIfStateEvents() {
 					p.Log.Debugf("Updating link state: %+v", ifState)
 				}
 				p.linkStateDescriptor.UpdateLinkState(ifState)
+				p.notifyStateSubscribers(ifState.State)
 				if p.PushNotification != nil {
 					p.PushNotification(&vpp.Notification{
 						Interface: ifState,
@@ -158,3 +160,40 @@ func (p *IfPlugin) publishIfStateEvents() {
 		}
 	}
 }
+
+// SetStatusFilter replaces glob patterns selecting interfaces whose state
+// is published to the status publishers. Takes effect for the next published
+// state; already published state of filtered-out interfaces is removed
+// on the next resync of the status data.
+func (p *IfPlugin) SetStatusFilter(patterns []string) error {
+	if err := validateStatusFilter(patterns); err != nil {
+		return err
+	}
+	p.publishLock.Lock()
+	defer p.publishLock.Unlock()
+	p.statusFilter = patterns
+	return nil
+}
+
+// isStatusPublished returns true if state of the given interface passes
+// the status filter. Expects publishLock to be locked.
+func (p *IfPlugin) isStatusPublished(ifaceName string) bool {
+	if len(p.statusFilter) == 0 {
+		return true
+	}
+	for _, pattern := range p.statusFilter {
+		if matched, _ := path.Match(pattern, ifaceName); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func validateStatusFilter(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid status filter pattern %q", pattern)
+		}
+	}
+	return nil
+}
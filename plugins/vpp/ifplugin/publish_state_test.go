@@ -0,0 +1,127 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ifplugin
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/datasync"
+	"go.ligato.io/cn-infra/v2/logging"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// mockStatusPublisher records keys of deleted status data.
+type mockStatusPublisher struct {
+	deleted []string
+}
+
+func (m *mockStatusPublisher) Put(key string, data proto.Message, opts ...datasync.PutOption) error {
+	if data == nil {
+		m.deleted = append(m.deleted, key)
+	}
+	return nil
+}
+
+func TestIsStatusPublished(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    []string
+		published []string
+		filtered  []string
+	}{
+		{
+			name:      "no filter",
+			published: []string{"tenantA-tap1", "memif1", "loop0"},
+		},
+		{
+			name:      "exact name",
+			filter:    []string{"memif1"},
+			published: []string{"memif1"},
+			filtered:  []string{"memif10", "memif", "tenantA-tap1"},
+		},
+		{
+			name:      "prefix",
+			filter:    []string{"tenantA-*"},
+			published: []string{"tenantA-tap1", "tenantA-"},
+			filtered:  []string{"tenantB-tap1", "xtenantA-tap1"},
+		},
+		{
+			name:      "single character and class",
+			filter:    []string{"memif?", "loop[0-1]"},
+			published: []string{"memif1", "loop0", "loop1"},
+			filtered:  []string{"memif10", "loop2"},
+		},
+		{
+			name:     "separator is not matched by wildcard",
+			filter:   []string{"tenantA-*"},
+			filtered: []string{"tenantA-tap1/sub"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			p := &IfPlugin{}
+			Expect(p.SetStatusFilter(test.filter)).To(Succeed())
+			for _, ifName := range test.published {
+				Expect(p.isStatusPublished(ifName)).To(BeTrue(), ifName)
+			}
+			for _, ifName := range test.filtered {
+				Expect(p.isStatusPublished(ifName)).To(BeFalse(), ifName)
+			}
+		})
+	}
+}
+
+func TestSetStatusFilterInvalidPattern(t *testing.T) {
+	RegisterTestingT(t)
+	p := &IfPlugin{}
+	Expect(p.SetStatusFilter([]string{"tenantA-*"})).To(Succeed())
+
+	err := p.SetStatusFilter([]string{"tenantB-*", "tenant[A"})
+	Expect(err).To(HaveOccurred())
+	Expect(err.Error()).To(ContainSubstring("tenant[A"))
+
+	// the previous filter stays in effect
+	Expect(p.isStatusPublished("tenantA-tap1")).To(BeTrue())
+	Expect(p.isStatusPublished("tenantB-tap1")).To(BeFalse())
+}
+
+func TestResyncIfStateEventsWithStatusFilter(t *testing.T) {
+	RegisterTestingT(t)
+	ifIndex := ifaceidx.NewIfaceIndex(logrus.DefaultLogger(), "test-iface-index")
+	ifIndex.Put("tenantA-tap1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndex.Put("tenantB-tap1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+	publisher := &mockStatusPublisher{}
+	p := &IfPlugin{intfIndex: ifIndex}
+	p.Log = logging.ForPlugin("ifplugin")
+	p.PublishStatistics = publisher
+	Expect(p.SetStatusFilter([]string{"tenantA-*"})).To(Succeed())
+
+	err := p.resyncIfStateEvents([]string{
+		interfaces.InterfaceStateKey("tenantA-tap1"), // published
+		interfaces.InterfaceStateKey("tenantB-tap1"), // filtered out
+		interfaces.InterfaceStateKey("tenantA-tap2"), // does not exist
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(publisher.deleted).To(Equal([]string{
+		interfaces.InterfaceStateKey("tenantB-tap1"),
+		interfaces.InterfaceStateKey("tenantA-tap2"),
+	}))
+}
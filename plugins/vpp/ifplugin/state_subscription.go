@@ -0,0 +1,209 @@
+// Copyright (c) 2019 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ifplugin
+
+import (
+	"sync"
+	"time"
+
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// IfStateEvent is delivered to subscribers on a change of the operational
+// state of a VPP interface.
+type IfStateEvent struct {
+	// Name is the logical name of the interface.
+	Name string
+	// PrevState is the previously delivered operational state
+	// (UNKNOWN_STATUS for the first event of the interface).
+	PrevState interfaces.InterfaceState_Status
+	// State is the current operational state (UP, DOWN or DELETED).
+	State interfaces.InterfaceState_Status
+	// Time of the state change.
+	Time time.Time
+}
+
+// ifStateSubscription is a single subscription for interface state events.
+type ifStateSubscription struct {
+	ifNames  map[string]struct{} // empty = all interfaces
+	ch       chan<- IfStateEvent
+	debounce time.Duration
+
+	// the latest not yet delivered event for every interface with changed state
+	// and the order in which the interfaces have changed (guarded by subsLock)
+	pending      map[string]IfStateEvent
+	pendingOrder []string
+
+	notify    chan struct{} // signals new pending event
+	done      chan struct{} // closed on unsubscribe
+	closeOnce sync.Once
+}
+
+// SubscribeInterfaceState registers channel to receive transitions of the
+// operational state for the given interfaces (or for all interfaces if no name
+// is given). Repeated notifications with unchanged state are not delivered.
+// Events are delivered by a separate go routine, a slow subscriber therefore
+// does not block the state updates. While the channel is full, only the latest
+// state of each interface is kept and delivered once the subscriber catches up.
+// With debouncing enabled (state-subscription-debounce), changes are delivered
+// only after the configured period and a change reverted within the period
+// is not delivered at all.
+// The returned function cancels the subscription.
+func (p *IfPlugin) SubscribeInterfaceState(ifNames []string, ch chan<- IfStateEvent) (unsubscribe func()) {
+	sub := &ifStateSubscription{
+		ifNames:  make(map[string]struct{}),
+		ch:       ch,
+		debounce: p.stateDebounce,
+		pending:  make(map[string]IfStateEvent),
+		notify:   make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	for _, ifName := range ifNames {
+		sub.ifNames[ifName] = struct{}{}
+	}
+
+	p.subsLock.Lock()
+	if p.stateSubs == nil {
+		p.stateSubs = make(map[*ifStateSubscription]struct{})
+	}
+	p.stateSubs[sub] = struct{}{}
+	p.subsLock.Unlock()
+
+	p.wg.Add(1)
+	go p.deliverStateEvents(sub)
+
+	return func() {
+		p.subsLock.Lock()
+		delete(p.stateSubs, sub)
+		p.subsLock.Unlock()
+		sub.closeOnce.Do(func() { close(sub.done) })
+	}
+}
+
+// notifyStateSubscribers passes change of the interface operational state
+// to the subscribers.
+func (p *IfPlugin) notifyStateSubscribers(ifState *interfaces.InterfaceState) {
+	p.subsLock.Lock()
+	defer p.subsLock.Unlock()
+
+	if p.lastOperState == nil {
+		p.lastOperState = make(map[string]interfaces.InterfaceState_Status)
+	}
+	prevState := p.lastOperState[ifState.Name]
+	if prevState == ifState.OperStatus {
+		// nothing has changed
+		return
+	}
+	if ifState.OperStatus == interfaces.InterfaceState_DELETED {
+		delete(p.lastOperState, ifState.Name)
+	} else {
+		p.lastOperState[ifState.Name] = ifState.OperStatus
+	}
+
+	event := IfStateEvent{
+		Name:      ifState.Name,
+		PrevState: prevState,
+		State:     ifState.OperStatus,
+		Time:      time.Now(),
+	}
+	for sub := range p.stateSubs {
+		if len(sub.ifNames) > 0 {
+			if _, subscribed := sub.ifNames[ifState.Name]; !subscribed {
+				continue
+			}
+		}
+		sub.addPending(event)
+	}
+}
+
+// addPending merges event with the pending (not yet delivered) event
+// of the same interface.
+func (sub *ifStateSubscription) addPending(event IfStateEvent) {
+	if pending, isPending := sub.pending[event.Name]; isPending {
+		// the subscriber has not seen the previous change yet
+		event.PrevState = pending.PrevState
+		if event.State == event.PrevState {
+			// the state has returned back before the change was delivered
+			delete(sub.pending, event.Name)
+			for i, ifName := range sub.pendingOrder {
+				if ifName == event.Name {
+					sub.pendingOrder = append(sub.pendingOrder[:i], sub.pendingOrder[i+1:]...)
+					break
+				}
+			}
+			return
+		}
+	} else {
+		sub.pendingOrder = append(sub.pendingOrder, event.Name)
+	}
+	sub.pending[event.Name] = event
+
+	select {
+	case sub.notify <- struct{}{}:
+	default:
+		// delivery already signaled
+	}
+}
+
+// takePending returns all pending events (in the order of the state changes)
+// and clears them.
+func (p *IfPlugin) takePending(sub *ifStateSubscription) (events []IfStateEvent) {
+	p.subsLock.Lock()
+	defer p.subsLock.Unlock()
+
+	for _, ifName := range sub.pendingOrder {
+		events = append(events, sub.pending[ifName])
+	}
+	sub.pending = make(map[string]IfStateEvent)
+	sub.pendingOrder = nil
+	return events
+}
+
+// deliverStateEvents runs in a separate go routine for every subscription
+// and delivers pending events to the subscriber.
+func (p *IfPlugin) deliverStateEvents(sub *ifStateSubscription) {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-sub.done:
+			return
+		case <-sub.notify:
+		}
+
+		if sub.debounce > 0 {
+			// wait for the state to settle
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-sub.done:
+				return
+			case <-time.After(sub.debounce):
+			}
+		}
+
+		for _, event := range p.takePending(sub) {
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-sub.done:
+				return
+			case sub.ch <- event:
+			}
+		}
+	}
+}
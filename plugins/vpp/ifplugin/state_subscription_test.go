@@ -0,0 +1,169 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ifplugin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	up      = interfaces.InterfaceState_UP
+	down    = interfaces.InterfaceState_DOWN
+	deleted = interfaces.InterfaceState_DELETED
+	unknown = interfaces.InterfaceState_UNKNOWN_STATUS
+)
+
+func newTestIfPlugin(debounce time.Duration) *IfPlugin {
+	p := &IfPlugin{stateDebounce: debounce}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	return p
+}
+
+func setOperState(p *IfPlugin, ifName string, state interfaces.InterfaceState_Status) {
+	p.notifyStateSubscribers(&interfaces.InterfaceState{Name: ifName, OperStatus: state})
+}
+
+// receiveTransitions returns (name, previous state, state) of the next <count> received events.
+func receiveTransitions(ch <-chan IfStateEvent, count int) (transitions [][3]interface{}) {
+	for i := 0; i < count; i++ {
+		var event IfStateEvent
+		Eventually(ch).Should(Receive(&event))
+		transitions = append(transitions, [3]interface{}{event.Name, event.PrevState, event.State})
+	}
+	return transitions
+}
+
+func TestSubscribeInterfaceState(t *testing.T) {
+	RegisterTestingT(t)
+	p := newTestIfPlugin(0)
+	defer func() {
+		p.cancel()
+		p.wg.Wait()
+	}()
+
+	ch := make(chan IfStateEvent, 10)
+	unsubscribe := p.SubscribeInterfaceState([]string{"if1"}, ch)
+
+	setOperState(p, "if1", up)
+	setOperState(p, "if2", up) // not subscribed
+	Expect(receiveTransitions(ch, 1)).To(Equal([][3]interface{}{{"if1", unknown, up}}))
+
+	setOperState(p, "if1", up) // unchanged
+	setOperState(p, "if1", down)
+	Expect(receiveTransitions(ch, 1)).To(Equal([][3]interface{}{{"if1", up, down}}))
+
+	setOperState(p, "if1", deleted)
+	Expect(receiveTransitions(ch, 1)).To(Equal([][3]interface{}{{"if1", down, deleted}}))
+	Consistently(ch, 100*time.Millisecond).ShouldNot(Receive())
+
+	// nothing is delivered after unsubscribe
+	unsubscribe()
+	unsubscribe() // repeated call is no-op
+	setOperState(p, "if1", up)
+	Consistently(ch, 100*time.Millisecond).ShouldNot(Receive())
+}
+
+func TestSubscribeInterfaceStateAllInterfaces(t *testing.T) {
+	RegisterTestingT(t)
+	p := newTestIfPlugin(0)
+	defer func() {
+		p.cancel()
+		p.wg.Wait()
+	}()
+
+	ch := make(chan IfStateEvent, 10)
+	p.SubscribeInterfaceState(nil, ch)
+
+	setOperState(p, "if1", up)
+	Expect(receiveTransitions(ch, 1)).To(Equal([][3]interface{}{{"if1", unknown, up}}))
+	setOperState(p, "if2", down)
+	Expect(receiveTransitions(ch, 1)).To(Equal([][3]interface{}{{"if2", unknown, down}}))
+}
+
+func TestSubscribeInterfaceStateSlowSubscriber(t *testing.T) {
+	RegisterTestingT(t)
+	p := newTestIfPlugin(0)
+	defer func() {
+		p.cancel()
+		p.wg.Wait()
+	}()
+
+	// nobody reads from the channel for now
+	ch := make(chan IfStateEvent)
+	p.SubscribeInterfaceState(nil, ch)
+
+	setOperState(p, "if1", up)
+	setOperState(p, "if2", up)
+	for i := 0; i < 10; i++ {
+		setOperState(p, "if1", down)
+		setOperState(p, "if1", up)
+	}
+	setOperState(p, "if1", down)
+
+	// the subscriber catches up - intermediate states may be skipped,
+	// but the events form a consistent sequence ending with the latest state
+	lastState := map[string]interfaces.InterfaceState_Status{}
+	for {
+		var event IfStateEvent
+		select {
+		case event = <-ch:
+		case <-time.After(200 * time.Millisecond):
+		}
+		if event.Name == "" {
+			break
+		}
+		Expect(event.PrevState).To(Equal(lastState[event.Name]))
+		Expect(event.State).ToNot(Equal(event.PrevState))
+		lastState[event.Name] = event.State
+	}
+	Expect(lastState).To(Equal(map[string]interfaces.InterfaceState_Status{
+		"if1": down,
+		"if2": up,
+	}))
+}
+
+func TestSubscribeInterfaceStateDebounce(t *testing.T) {
+	RegisterTestingT(t)
+	debounce := 100 * time.Millisecond
+	p := newTestIfPlugin(debounce)
+	defer func() {
+		p.cancel()
+		p.wg.Wait()
+	}()
+
+	ch := make(chan IfStateEvent, 10)
+	p.SubscribeInterfaceState([]string{"if1"}, ch)
+
+	setOperState(p, "if1", up)
+	Consistently(ch, debounce/2).ShouldNot(Receive())
+	Expect(receiveTransitions(ch, 1)).To(Equal([][3]interface{}{{"if1", unknown, up}}))
+
+	// flapping is filtered out
+	setOperState(p, "if1", down)
+	setOperState(p, "if1", up)
+	Consistently(ch, 3*debounce).ShouldNot(Receive())
+
+	// multiple changes within the period are merged
+	setOperState(p, "if1", down)
+	setOperState(p, "if1", deleted)
+	Expect(receiveTransitions(ch, 1)).To(Equal([][3]interface{}{{"if1", up, deleted}}))
+	Consistently(ch, 3*debounce).ShouldNot(Receive())
+}
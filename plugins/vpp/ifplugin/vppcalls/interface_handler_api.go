@@ -208,6 +208,15 @@ type InterfaceVppAPI interface {
 	SetInterfaceMac(ifIdx uint32, macAddress string) error
 	// SetInterfaceMtu calls HwInterfaceSetMtu bin API with desired MTU value.
 	SetInterfaceMtu(ifIdx uint32, mtu uint32) error
+	// SetInterfaceDirectedBroadcast calls SwInterfaceSetIPDirectedBroadcast bin API.
+	SetInterfaceDirectedBroadcast(ifIdx uint32, enable bool) error
+	// SetInterfaceIP6Enable calls SwInterfaceIP6EnableDisable bin API.
+	SetInterfaceIP6Enable(ifIdx uint32, enable bool) error
+	// GetInterfaceIP6Enabled returns true if IPv6 is enabled on the interface
+	// with the given VPP internal name.
+	GetInterfaceIP6Enabled(internalName string) (bool, error)
+	// SetInterfaceDetailedStats calls CollectDetailedInterfaceStats bin API.
+	SetInterfaceDetailedStats(ifIdx uint32, enable bool) error
 	// SetRxMode calls SwInterfaceSetRxMode bin API
 	SetRxMode(ifIdx uint32, rxMode *interfaces.Interface_RxMode) error
 	// SetRxPlacement configures rx-placement for interface
@@ -211,6 +211,11 @@ func (h *InterfaceVppHandler) DumpInterfaces(ctx context.Context) (map[uint32]*v
 		} else {
 			ifData.Interface.Vrf = ipv4Vrf
 		}
+		// dual-stack interface with IPv6 assigned to a different VRF table than IPv4
+		if ipv4Vrf != ipv6Vrf && ifData.Interface.Vrf == ipv6Vrf && hasIPv4Address(ifData.Interface) {
+			ifData.Interface.Vrf = ipv4Vrf
+			ifData.Interface.VrfIpv6 = ipv6Vrf
+		}
 
 		// DHCP
 		dhcpData, ok := dhcpClients[ifData.Meta.SwIfIndex]
@@ -429,6 +434,16 @@ func toLinkSpeed(speed uint32) uint64 {
 	}
 }
 
+// hasIPv4Address returns true if the interface has at least one IPv4 address assigned.
+func hasIPv4Address(iface *interfaces.Interface) bool {
+	for _, ifAddress := range iface.IpAddresses {
+		if ipAddress, _, err := net.ParseCIDR(ifAddress); err == nil && ipAddress.To4() != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // Returns true if given interface contains at least one IPv6 address. For VxLAN, source and destination
 // addresses are also checked
 func isIpv6Interface(iface *interfaces.Interface) (bool, error) {
@@ -0,0 +1,61 @@
+//  Copyright (c) 2019 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp1908_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp1908/ip"
+)
+
+func TestSetInterfaceIP6Enable(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip.SwInterfaceIP6EnableDisableReply{})
+
+	err := ifHandler.SetInterfaceIP6Enable(1, true)
+
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*ip.SwInterfaceIP6EnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.Enable).To(BeEquivalentTo(1))
+}
+
+func TestSetInterfaceIP6EnableError(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip.SwInterfaceIP6EnableDisable{})
+
+	err := ifHandler.SetInterfaceIP6Enable(1, true)
+
+	Expect(err).ToNot(BeNil())
+}
+
+func TestSetInterfaceIP6EnableRetval(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip.SwInterfaceIP6EnableDisableReply{
+		Retval: 1,
+	})
+
+	err := ifHandler.SetInterfaceIP6Enable(1, true)
+
+	Expect(err).ToNot(BeNil())
+}
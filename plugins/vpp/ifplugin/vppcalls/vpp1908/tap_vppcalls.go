@@ -36,6 +36,9 @@ func (h *InterfaceVppHandler) AddTapInterface(ifName string, tapIf *interfaces.T
 	if tapIf.Version == 1 {
 		return 0, errors.New("tap version 1 has been deprecated")
 	} else if tapIf.Version == 2 {
+		if tapIf.EnableCsumOffload {
+			return 0, errors.New("checksum offload for TAP is not supported by this VPP version")
+		}
 		var flags uint32
 		if tapIf.EnableGso {
 			flags |= TapFlagGSO
@@ -230,6 +230,11 @@ func (h *InterfaceVppHandler) DumpInterfaces(ctx context.Context) (map[uint32]*v
 		} else {
 			ifData.Interface.Vrf = ipv4Vrf
 		}
+		// dual-stack interface with IPv6 assigned to a different VRF table than IPv4
+		if ipv4Vrf != ipv6Vrf && ifData.Interface.Vrf == ipv6Vrf && hasIPv4Address(ifData.Interface) {
+			ifData.Interface.Vrf = ipv4Vrf
+			ifData.Interface.VrfIpv6 = ipv6Vrf
+		}
 
 		// DHCP
 		dhcpData, ok := dhcpClients[ifData.Meta.SwIfIndex]
@@ -421,6 +426,16 @@ func toLinkSpeed(speed uint32) uint64 {
 	}
 }
 
+// hasIPv4Address returns true if the interface has at least one IPv4 address assigned.
+func hasIPv4Address(iface *ifs.Interface) bool {
+	for _, ifAddress := range iface.IpAddresses {
+		if ipAddress, _, err := net.ParseCIDR(ifAddress); err == nil && ipAddress.To4() != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // Returns true if given interface contains at least one IPv6 address. For VxLAN, source and destination
 // addresses are also checked
 func isIpv6Interface(iface *ifs.Interface) (bool, error) {
@@ -515,11 +530,12 @@ func (h *InterfaceVppHandler) dumpTapDetails(interfaces map[uint32]*vppcalls.Int
 		}
 		interfaces[tapDetails.SwIfIndex].Interface.Link = &ifs.Interface_Tap{
 			Tap: &ifs.TapLink{
-				Version:    2,
-				HostIfName: cleanString(tapDetails.HostIfName),
-				RxRingSize: uint32(tapDetails.RxRingSz),
-				TxRingSize: uint32(tapDetails.TxRingSz),
-				EnableGso:  tapDetails.TapFlags&vpp_tapv2.TAP_FLAG_GSO == vpp_tapv2.TAP_FLAG_GSO,
+				Version:           2,
+				HostIfName:        cleanString(tapDetails.HostIfName),
+				RxRingSize:        uint32(tapDetails.RxRingSz),
+				TxRingSize:        uint32(tapDetails.TxRingSz),
+				EnableGso:         tapDetails.TapFlags&vpp_tapv2.TAP_FLAG_GSO == vpp_tapv2.TAP_FLAG_GSO,
+				EnableCsumOffload: tapDetails.TapFlags&vpp_tapv2.TAP_FLAG_CSUM_OFFLOAD == vpp_tapv2.TAP_FLAG_CSUM_OFFLOAD,
 			},
 		}
 		interfaces[tapDetails.SwIfIndex].Interface.Type = ifs.Interface_TAP
@@ -517,3 +517,83 @@ func TestDumpInterfacesGtpu(t *testing.T) {
 	Expect(intface.GetGtpu().EncapVrfId).To(Equal(uint32(16)))
 	Expect(intface.GetGtpu().Teid).To(Equal(uint32(100)))
 }
+
+// Test dump of dual-stack interface with IPv4 and IPv6 in different VRF tables
+func TestDumpInterfacesDualStackVrf(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	var ipv4Addr, ipv6Addr ip_types.AddressUnion
+	ipv4Addr.SetIP4(ip_types.IP4Address{10, 0, 0, 1})
+	var ipv6 ip_types.IP6Address
+	copy(ipv6[:], net.ParseIP("2001:db8::1").To16())
+	ipv6Addr.SetIP6(ipv6)
+
+	ctx.MockReplies([]*vppmock.HandleReplies{
+		{
+			Name: (&vpp_interfaces.SwInterfaceDump{}).GetMessageName(),
+			Ping: true,
+			Message: &vpp_interfaces.SwInterfaceDetails{
+				InterfaceName: "loop0",
+			},
+		},
+		{
+			// IPv4 table is requested first, IPv6 second
+			Name: (&vpp_interfaces.SwInterfaceGetTable{}).GetMessageName(),
+			Ping: false,
+			Sequence: []govppapi.Message{
+				&vpp_interfaces.SwInterfaceGetTableReply{VrfID: 10},
+				&vpp_interfaces.SwInterfaceGetTableReply{VrfID: 20},
+			},
+		},
+		{
+			Name: (&vpp_ip.IPAddressDump{}).GetMessageName(),
+			Ping: true,
+			Sequence: []govppapi.Message{
+				&vpp_ip.IPAddressDetails{
+					Prefix: ip_types.AddressWithPrefix{
+						Address: ip_types.Address{Af: ip_types.ADDRESS_IP4, Un: ipv4Addr},
+						Len:     24,
+					},
+				},
+				&vpp_ip.IPAddressDetails{
+					Prefix: ip_types.AddressWithPrefix{
+						Address: ip_types.Address{Af: ip_types.ADDRESS_IP6, Un: ipv6Addr},
+						Len:     64,
+					},
+				},
+			},
+		},
+		{
+			Name: (&vpp_memif.MemifSocketFilenameDump{}).GetMessageName(),
+			Ping: true,
+		},
+		{
+			Name: (&vpp_memif.MemifDump{}).GetMessageName(),
+			Ping: true,
+		},
+		{
+			Name: (&vpp_tapv2.SwInterfaceTapV2Dump{}).GetMessageName(),
+			Ping: true,
+		},
+		{
+			Name: (&vpp_vxlan.VxlanTunnelDump{}).GetMessageName(),
+			Ping: true,
+		},
+		{
+			Name: (&vpp_gtpu.GtpuTunnelDump{}).GetMessageName(),
+			Ping: true,
+		},
+	})
+
+	intfs, err := ifHandler.DumpInterfaces(ctx.Context)
+	Expect(err).To(BeNil())
+	Expect(intfs).To(HaveLen(1))
+	intface := intfs[0].Interface
+
+	Expect(intface.IpAddresses).To(ConsistOf("10.0.0.1/24", "2001:db8::1/64"))
+	Expect(intface.Vrf).To(Equal(uint32(10)))
+	Expect(intface.VrfIpv6).To(Equal(uint32(20)))
+	Expect(intfs[0].Meta.VrfIPv4).To(Equal(uint32(10)))
+	Expect(intfs[0].Meta.VrfIPv6).To(Equal(uint32(20)))
+}
@@ -0,0 +1,53 @@
+//  Copyright (c) 2019 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2001
+
+import (
+	"strings"
+
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2001/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2001/vpe"
+)
+
+// SetInterfaceIP6Enable implements interface handler.
+func (h *InterfaceVppHandler) SetInterfaceIP6Enable(ifIdx uint32, enable bool) error {
+	req := &vpp_ip.SwInterfaceIP6EnableDisable{
+		SwIfIndex: vpp_ip.InterfaceIndex(ifIdx),
+		Enable:    enable,
+	}
+	reply := &vpp_ip.SwInterfaceIP6EnableDisableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetInterfaceIP6Enabled implements interface handler.
+func (h *InterfaceVppHandler) GetInterfaceIP6Enabled(internalName string) (bool, error) {
+	// IPv6 enablement cannot be dumped via binary API, link-local address
+	// is listed by the CLI only for interfaces with IPv6 enabled
+	req := &vpe.CliInband{
+		Cmd: "show ip6 interface " + internalName,
+	}
+	reply := &vpe.CliInbandReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return false, err
+	}
+
+	return strings.Contains(reply.Reply, "Link-local address"), nil
+}
@@ -0,0 +1,93 @@
+//  Copyright (c) 2019 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2001_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2001/ip"
+	vpp_vpe "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2001/vpe"
+)
+
+func TestSetInterfaceIP6Enable(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.SwInterfaceIP6EnableDisableReply{})
+
+	err := ifHandler.SetInterfaceIP6Enable(1, true)
+
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_ip.SwInterfaceIP6EnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.Enable).To(BeTrue())
+}
+
+func TestSetInterfaceIP6EnableError(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.SwInterfaceIP6EnableDisable{})
+
+	err := ifHandler.SetInterfaceIP6Enable(1, true)
+
+	Expect(err).ToNot(BeNil())
+}
+
+func TestSetInterfaceIP6EnableRetval(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.SwInterfaceIP6EnableDisableReply{
+		Retval: 1,
+	})
+
+	err := ifHandler.SetInterfaceIP6Enable(1, true)
+
+	Expect(err).ToNot(BeNil())
+}
+
+func TestGetInterfaceIP6Enabled(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_vpe.CliInbandReply{
+		Reply: "loop0 is admin up\n\tLink-local address(es):\n\t\tfe80::dead:beef/64\n",
+	})
+
+	enabled, err := ifHandler.GetInterfaceIP6Enabled("loop0")
+
+	Expect(err).To(BeNil())
+	Expect(enabled).To(BeTrue())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_vpe.CliInband)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Cmd).To(Equal("show ip6 interface loop0"))
+}
+
+func TestGetInterfaceIP6Disabled(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_vpe.CliInbandReply{
+		Reply: "show ip6 interface: IPv6 not enabled on interface\n",
+	})
+
+	enabled, err := ifHandler.GetInterfaceIP6Enabled("loop0")
+
+	Expect(err).To(BeNil())
+	Expect(enabled).To(BeFalse())
+}
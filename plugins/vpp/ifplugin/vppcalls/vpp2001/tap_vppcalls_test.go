@@ -52,6 +52,34 @@ func TestAddTapInterfaceV2(t *testing.T) {
 	Expect(msgCheck).To(BeTrue())
 }
 
+func TestAddTapInterfaceV2WithOffloads(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_tapv2.TapCreateV2Reply{
+		SwIfIndex: 1,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	swIfIdx, err := ifHandler.AddTapInterface("tapIf", &ifs.TapLink{
+		Version:           2,
+		HostIfName:        "hostIf",
+		EnableGso:         true,
+		EnableCsumOffload: true,
+	})
+	Expect(err).To(BeNil())
+	Expect(swIfIdx).To(BeEquivalentTo(1))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_tapv2.TapCreateV2)
+		if ok {
+			Expect(vppMsg.TapFlags).To(Equal(vpp_tapv2.TAP_FLAG_GSO | vpp_tapv2.TAP_FLAG_CSUM_OFFLOAD))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
 func TestDeleteTapInterfaceV2(t *testing.T) {
 	ctx, ifHandler := ifTestSetup(t)
 	defer ctx.TeardownTestCtx()
@@ -0,0 +1,141 @@
+//  Copyright (c) 2019 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/golang/protobuf/proto"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+const (
+	// IPReassemblyDescriptorName is the name of the descriptor.
+	IPReassemblyDescriptorName = "vpp-ip-reassembly"
+)
+
+// IPReassemblyDescriptor teaches KVScheduler how to configure global
+// IPv4/IPv6 reassembly settings in VPP.
+type IPReassemblyDescriptor struct {
+	log                 logging.Logger
+	ipReass             vppcalls.IPReassemblyVppAPI
+	defaultIPReassembly *l3.IPReassembly
+}
+
+// NewIPReassemblyDescriptor creates a new instance of the IPReassemblyDescriptor.
+func NewIPReassemblyDescriptor(
+	ipReassHandler vppcalls.IPReassemblyVppAPI,
+	log logging.PluginLogger,
+) *kvs.KVDescriptor {
+
+	ctx := &IPReassemblyDescriptor{
+		ipReass:             ipReassHandler,
+		log:                 log.NewLogger("ip-reassembly-descriptor"),
+		defaultIPReassembly: ipReassHandler.DefaultIPReassembly(),
+	}
+
+	typedDescr := &adapter.IPReassemblyDescriptor{
+		Name:            IPReassemblyDescriptorName,
+		NBKeyPrefix:     l3.ModelIPReassembly.KeyPrefix(),
+		ValueTypeName:   l3.ModelIPReassembly.ProtoName(),
+		KeySelector:     l3.ModelIPReassembly.IsKeyValid,
+		ValueComparator: ctx.EquivalentIPReassembly,
+		Create:          ctx.Create,
+		Update:          ctx.Update,
+		Delete:          ctx.Delete,
+		Retrieve:        ctx.Retrieve,
+	}
+	return adapter.NewIPReassemblyDescriptor(typedDescr)
+}
+
+// EquivalentIPReassembly compares the IP reassembly values. Unset fields
+// are treated as equal to the VPP defaults.
+func (d *IPReassemblyDescriptor) EquivalentIPReassembly(key string, oldValue, newValue *l3.IPReassembly) bool {
+	return proto.Equal(d.withDefaults(oldValue), d.withDefaults(newValue))
+}
+
+// Create applies IP reassembly settings.
+func (d *IPReassemblyDescriptor) Create(key string, value *l3.IPReassembly) (metadata interface{}, err error) {
+	return d.Update(key, d.defaultIPReassembly, value, nil)
+}
+
+// Delete reverts IP reassembly settings back to the VPP defaults.
+func (d *IPReassemblyDescriptor) Delete(key string, value *l3.IPReassembly, metadata interface{}) error {
+	_, err := d.Update(key, value, d.defaultIPReassembly, metadata)
+	return err
+}
+
+// Update modifies IP reassembly settings.
+func (d *IPReassemblyDescriptor) Update(key string, oldValue, newValue *l3.IPReassembly, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	if err := d.ipReass.SetIPReassembly(d.withDefaults(newValue)); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Retrieve returns current IP reassembly settings.
+func (d *IPReassemblyDescriptor) Retrieve(correlate []adapter.IPReassemblyKVWithMetadata) (
+	retrieved []adapter.IPReassemblyKVWithMetadata, err error,
+) {
+	ipReass, err := d.ipReass.GetIPReassembly()
+	if err != nil {
+		return nil, err
+	}
+
+	var origin = kvs.FromNB
+	if proto.Equal(ipReass, d.withDefaults(d.defaultIPReassembly)) {
+		origin = kvs.FromSB
+	}
+
+	retrieved = append(retrieved, adapter.IPReassemblyKVWithMetadata{
+		Key:    models.Key(ipReass),
+		Value:  ipReass,
+		Origin: origin,
+	})
+
+	return retrieved, nil
+}
+
+func (d *IPReassemblyDescriptor) withDefaults(orig *l3.IPReassembly) *l3.IPReassembly {
+	def := d.defaultIPReassembly
+	return &l3.IPReassembly{
+		Ipv4: reassemblySettingsWithDefaults(orig.GetIpv4(), def.GetIpv4()),
+		Ipv6: reassemblySettingsWithDefaults(orig.GetIpv6(), def.GetIpv6()),
+	}
+}
+
+func reassemblySettingsWithDefaults(orig, def *l3.IPReassembly_Settings) *l3.IPReassembly_Settings {
+	var val l3.IPReassembly_Settings
+	if orig != nil {
+		val = *orig
+	}
+	if val.TimeoutMs == 0 {
+		val.TimeoutMs = def.GetTimeoutMs()
+	}
+	if val.MaxReassemblies == 0 {
+		val.MaxReassemblies = def.GetMaxReassemblies()
+	}
+	if val.MaxReassemblyLength == 0 {
+		val.MaxReassemblyLength = def.GetMaxReassemblyLength()
+	}
+	if val.ExpireWalkIntervalMs == 0 {
+		val.ExpireWalkIntervalMs = def.GetExpireWalkIntervalMs()
+	}
+	return &val
+}
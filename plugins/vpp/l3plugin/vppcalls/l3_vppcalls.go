@@ -40,6 +40,7 @@ type L3VppAPI interface {
 	ProxyArpVppAPI
 	RouteVppAPI
 	IPNeighVppAPI
+	IPReassemblyVppAPI
 	VrfTableVppAPI
 	DHCPProxyAPI
 	L3XCVppAPI
@@ -212,6 +213,16 @@ type IPNeighVppAPI interface {
 	DefaultIPScanNeighbor() *l3.IPScanNeighbor
 }
 
+// IPReassemblyVppAPI provides methods for managing global IP reassembly settings
+type IPReassemblyVppAPI interface {
+	// SetIPReassembly configures IPv4 and IPv6 reassembly settings in the VPP
+	SetIPReassembly(data *l3.IPReassembly) error
+	// GetIPReassembly returns IPv4 and IPv6 reassembly settings from the VPP
+	GetIPReassembly() (*l3.IPReassembly, error)
+	// DefaultIPReassembly returns default IP reassembly settings
+	DefaultIPReassembly() *l3.IPReassembly
+}
+
 // Path represents FIB path entry.
 type Path struct {
 	SwIfIndex  uint32
@@ -0,0 +1,105 @@
+//  Copyright (c) 2019 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp1908
+
+import (
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp1908/ip"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+// DefaultIPReassembly implements ip reassembly handler.
+func (h *IPReassemblyHandler) DefaultIPReassembly() *l3.IPReassembly {
+	return &l3.IPReassembly{
+		Ipv4: defaultReassemblySettings(),
+		Ipv6: defaultReassemblySettings(),
+	}
+}
+
+func defaultReassemblySettings() *l3.IPReassembly_Settings {
+	return &l3.IPReassembly_Settings{
+		TimeoutMs:            100,
+		MaxReassemblies:      1024,
+		MaxReassemblyLength:  3,
+		ExpireWalkIntervalMs: 10000,
+	}
+}
+
+// SetIPReassembly implements ip reassembly handler.
+func (h *IPReassemblyHandler) SetIPReassembly(data *l3.IPReassembly) error {
+	if data.GetIpv4() != nil {
+		if err := h.setIPReassembly(data.GetIpv4(), false); err != nil {
+			return errors.Wrap(err, "failed to set IPv4 reassembly")
+		}
+	}
+	if data.GetIpv6() != nil {
+		if err := h.setIPReassembly(data.GetIpv6(), true); err != nil {
+			return errors.Wrap(err, "failed to set IPv6 reassembly")
+		}
+	}
+	return nil
+}
+
+func (h *IPReassemblyHandler) setIPReassembly(settings *l3.IPReassembly_Settings, isIPv6 bool) error {
+	req := &ip.IPReassemblySet{
+		TimeoutMs:            settings.TimeoutMs,
+		MaxReassemblies:      settings.MaxReassemblies,
+		MaxReassemblyLength:  settings.MaxReassemblyLength,
+		ExpireWalkIntervalMs: settings.ExpireWalkIntervalMs,
+		IsIP6:                boolToUint(isIPv6),
+	}
+	reply := &ip.IPReassemblySetReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetIPReassembly dumps current IPv4 and IPv6 reassembly settings.
+func (h *IPReassemblyHandler) GetIPReassembly() (*l3.IPReassembly, error) {
+	ipv4, err := h.getIPReassembly(false)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get IPv4 reassembly")
+	}
+	ipv6, err := h.getIPReassembly(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get IPv6 reassembly")
+	}
+	return &l3.IPReassembly{
+		Ipv4: ipv4,
+		Ipv6: ipv6,
+	}, nil
+}
+
+func (h *IPReassemblyHandler) getIPReassembly(isIPv6 bool) (*l3.IPReassembly_Settings, error) {
+	req := &ip.IPReassemblyGet{
+		IsIP6: boolToUint(isIPv6),
+	}
+	reply := &ip.IPReassemblyGetReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return nil, err
+	}
+
+	return &l3.IPReassembly_Settings{
+		TimeoutMs:            reply.TimeoutMs,
+		MaxReassemblies:      reply.MaxReassemblies,
+		MaxReassemblyLength:  reply.MaxReassemblyLength,
+		ExpireWalkIntervalMs: reply.ExpireWalkIntervalMs,
+	}, nil
+}
@@ -50,6 +50,7 @@ type L3VppHandler struct {
 	*ProxyArpVppHandler
 	*RouteHandler
 	*IPNeighHandler
+	*IPReassemblyHandler
 	*VrfTableHandler
 	*DHCPProxyHandler
 	*L3XCHandler
@@ -68,13 +69,14 @@ func NewL3VppHandler(
 		return nil
 	}
 	return &L3VppHandler{
-		ArpVppHandler:      NewArpVppHandler(ch, ifIdx, log),
-		ProxyArpVppHandler: NewProxyArpVppHandler(ch, ifIdx, log),
-		RouteHandler:       NewRouteVppHandler(ch, ifIdx, vrfIdx, addrAlloc, log),
-		IPNeighHandler:     NewIPNeighVppHandler(ch, log),
-		VrfTableHandler:    NewVrfTableVppHandler(ch, log),
-		DHCPProxyHandler:   NewDHCPProxyHandler(ch, log),
-		L3XCHandler:        NewL3XCHandler(c, ifIdx, log),
+		ArpVppHandler:       NewArpVppHandler(ch, ifIdx, log),
+		ProxyArpVppHandler:  NewProxyArpVppHandler(ch, ifIdx, log),
+		RouteHandler:        NewRouteVppHandler(ch, ifIdx, vrfIdx, addrAlloc, log),
+		IPNeighHandler:      NewIPNeighVppHandler(ch, log),
+		IPReassemblyHandler: NewIPReassemblyVppHandler(ch, log),
+		VrfTableHandler:     NewVrfTableVppHandler(ch, log),
+		DHCPProxyHandler:    NewDHCPProxyHandler(ch, log),
+		L3XCHandler:         NewL3XCHandler(c, ifIdx, log),
 	}
 }
 
@@ -115,6 +117,12 @@ type IPNeighHandler struct {
 	vpevppcalls.VppCoreAPI
 }
 
+// IPReassemblyHandler is accessor for ip-reassembly-related vppcalls methods
+type IPReassemblyHandler struct {
+	callsChannel govppapi.Channel
+	log          logging.Logger
+}
+
 // VrfTableHandler is accessor for vrf-related vppcalls methods
 type VrfTableHandler struct {
 	callsChannel govppapi.Channel
@@ -173,6 +181,17 @@ func NewIPNeighVppHandler(callsChan govppapi.Channel, log logging.Logger) *IPNei
 	}
 }
 
+// NewIPReassemblyVppHandler creates new instance of ip reassembly vppcalls handler
+func NewIPReassemblyVppHandler(callsChan govppapi.Channel, log logging.Logger) *IPReassemblyHandler {
+	if log == nil {
+		log = logrus.NewLogger("ip-reassembly")
+	}
+	return &IPReassemblyHandler{
+		callsChannel: callsChan,
+		log:          log,
+	}
+}
+
 // NewVrfTableVppHandler creates new instance of vrf-table vppcalls handler
 func NewVrfTableVppHandler(callsChan govppapi.Channel, log logging.Logger) *VrfTableHandler {
 	if log == nil {
@@ -0,0 +1,85 @@
+//  Copyright (c) 2019 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2001
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2001/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+func TestSetIPReassembly(t *testing.T) {
+	ctx := vppmock.SetupTestCtx(t)
+	defer ctx.TeardownTestCtx()
+
+	handler := NewIPReassemblyVppHandler(ctx.MockChannel, nil)
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPReassemblySetReply{})
+	ctx.MockVpp.MockReply(&vpp_ip.IPReassemblySetReply{})
+	err := handler.SetIPReassembly(&l3.IPReassembly{
+		Ipv4: &l3.IPReassembly_Settings{
+			TimeoutMs:            200,
+			MaxReassemblies:      2048,
+			ExpireWalkIntervalMs: 5000,
+		},
+		Ipv6: &l3.IPReassembly_Settings{
+			TimeoutMs:            300,
+			MaxReassemblies:      512,
+			MaxReassemblyLength:  5,
+			ExpireWalkIntervalMs: 1000,
+		},
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	msg, ok := ctx.MockChannel.Msg.(*vpp_ip.IPReassemblySet)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsIP6).To(BeTrue())
+	Expect(msg.Type).To(Equal(vpp_ip.IP_REASS_TYPE_FULL))
+	Expect(msg.TimeoutMs).To(BeEquivalentTo(300))
+	Expect(msg.MaxReassemblies).To(BeEquivalentTo(512))
+	Expect(msg.MaxReassemblyLength).To(BeEquivalentTo(5))
+	Expect(msg.ExpireWalkIntervalMs).To(BeEquivalentTo(1000))
+}
+
+func TestGetIPReassembly(t *testing.T) {
+	ctx := vppmock.SetupTestCtx(t)
+	defer ctx.TeardownTestCtx()
+
+	handler := NewIPReassemblyVppHandler(ctx.MockChannel, nil)
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPReassemblyGetReply{
+		TimeoutMs:            100,
+		MaxReassemblies:      1024,
+		ExpireWalkIntervalMs: 10000,
+	})
+	ctx.MockVpp.MockReply(&vpp_ip.IPReassemblyGetReply{
+		TimeoutMs:            200,
+		MaxReassemblies:      64,
+		MaxReassemblyLength:  2,
+		ExpireWalkIntervalMs: 500,
+		IsIP6:                true,
+	})
+	reass, err := handler.GetIPReassembly()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(reass.Ipv4.TimeoutMs).To(BeEquivalentTo(100))
+	Expect(reass.Ipv4.MaxReassemblies).To(BeEquivalentTo(1024))
+	Expect(reass.Ipv4.ExpireWalkIntervalMs).To(BeEquivalentTo(10000))
+	Expect(reass.Ipv6.TimeoutMs).To(BeEquivalentTo(200))
+	Expect(reass.Ipv6.MaxReassemblies).To(BeEquivalentTo(64))
+	Expect(reass.Ipv6.MaxReassemblyLength).To(BeEquivalentTo(2))
+	Expect(reass.Ipv6.ExpireWalkIntervalMs).To(BeEquivalentTo(500))
+}
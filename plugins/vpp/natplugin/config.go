@@ -0,0 +1,43 @@
+// Copyright (c) 2018 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package natplugin
+
+// Config defines configuration for VPP NAT plugin.
+type Config struct {
+	// remove NAT44 sessions of removed or changed DNAT mappings
+	PurgeStaleSessions bool `json:"purge-stale-sessions"`
+}
+
+// DefaultConfig returns Config with default values.
+func DefaultConfig() Config {
+	return Config{
+		PurgeStaleSessions: false,
+	}
+}
+
+func (p *NATPlugin) loadConfig() (*Config, error) {
+	cfg := DefaultConfig()
+
+	found, err := p.Cfg.LoadValue(&cfg)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		p.Log.Debugf("config %s not found", p.Cfg.GetConfigName())
+		return &cfg, nil
+	}
+	p.Log.Debugf("config %s found: %+v", p.Cfg.GetConfigName(), cfg)
+
+	return &cfg, nil
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"net"
 
+	prototypes "github.com/golang/protobuf/ptypes/empty"
 	"go.ligato.io/cn-infra/v2/logging"
 
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
@@ -67,6 +68,7 @@ func NewNAT44AddressPoolDescriptor(nat44GlobalDesc *NAT44GlobalDescriptor,
 		Delete:        ctx.Delete,
 		Retrieve:      ctx.Retrieve,
 		Dependencies:  ctx.Dependencies,
+		DerivedValues: ctx.DerivedValues,
 		// retrieve global NAT config first (required for deprecated global NAT interface & address API)
 		RetrieveDependencies: []string{NAT44GlobalDescriptorName},
 	}
@@ -135,3 +137,15 @@ func (d *NAT44AddressPoolDescriptor) Dependencies(key string, natAddr *nat.Nat44
 		},
 	}
 }
+
+// DerivedValues derives empty value under the twice-NAT pool key for pools
+// enabled for twice-NAT (for DNAT mappings to depend on).
+func (d *NAT44AddressPoolDescriptor) DerivedValues(key string, natAddr *nat.Nat44AddressPool) (derValues []kvs.KeyValuePair) {
+	if natAddr.TwiceNat {
+		derValues = append(derValues, kvs.KeyValuePair{
+			Key:   nat.DerivedTwiceNAT44PoolKey(natAddr.VrfId, natAddr.FirstIp, natAddr.LastIp),
+			Value: &prototypes.Empty{},
+		})
+	}
+	return derValues
+}
@@ -42,6 +42,7 @@ const (
 	// dependency labels
 	mappingInterfaceDep = "interface-exists"
 	mappingVrfDep       = "vrf-table-exists"
+	twiceNATPoolDep     = "twice-nat-pool-exists"
 )
 
 // A list of non-retriable errors:
@@ -49,34 +50,47 @@ var (
 	// ErrDNAT44WithEmptyLabel is returned when NAT44 DNAT configuration is defined
 	// with empty label
 	ErrDNAT44WithEmptyLabel = errors.New("NAT44 DNAT configuration defined with empty label")
+
+	// ErrDNAT44RequiresEDMode is returned when NAT44 DNAT configuration contains
+	// twice-NAT or load-balanced static mapping while NAT44 is not running
+	// in the endpoint-dependent mode
+	ErrDNAT44RequiresEDMode = errors.New("twice-NAT and load-balanced static mappings require NAT44 " +
+		"in the endpoint-dependent mode (see nat { endpoint-dependent } in VPP startup config)")
 )
 
 // DNAT44Descriptor teaches KVScheduler how to configure Destination NAT44 in VPP.
 type DNAT44Descriptor struct {
 	log        logging.Logger
 	natHandler vppcalls.NatVppAPI
+
+	// remove sessions of removed/changed mappings
+	purgeSessions bool
 }
 
 // NewDNAT44Descriptor creates a new instance of the DNAT44 descriptor.
-func NewDNAT44Descriptor(natHandler vppcalls.NatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+// With <purgeSessions> enabled, NAT44 sessions of removed (or changed) mappings
+// are deleted instead of waiting for them to time out.
+func NewDNAT44Descriptor(natHandler vppcalls.NatVppAPI, purgeSessions bool, log logging.PluginLogger) *kvs.KVDescriptor {
 	ctx := &DNAT44Descriptor{
-		natHandler: natHandler,
-		log:        log.NewLogger("nat44-dnat-descriptor"),
+		natHandler:    natHandler,
+		purgeSessions: purgeSessions,
+		log:           log.NewLogger("nat44-dnat-descriptor"),
 	}
 
 	typedDescr := &adapter.DNAT44Descriptor{
-		Name:            DNAT44DescriptorName,
-		NBKeyPrefix:     nat.ModelDNat44.KeyPrefix(),
-		ValueTypeName:   nat.ModelDNat44.ProtoName(),
-		KeySelector:     nat.ModelDNat44.IsKeyValid,
-		KeyLabel:        nat.ModelDNat44.StripKeyPrefix,
-		ValueComparator: ctx.EquivalentDNAT44,
-		Validate:        ctx.Validate,
-		Create:          ctx.Create,
-		Delete:          ctx.Delete,
-		Update:          ctx.Update,
-		Retrieve:        ctx.Retrieve,
-		Dependencies:    ctx.Dependencies,
+		Name:               DNAT44DescriptorName,
+		NBKeyPrefix:        nat.ModelDNat44.KeyPrefix(),
+		ValueTypeName:      nat.ModelDNat44.ProtoName(),
+		KeySelector:        nat.ModelDNat44.IsKeyValid,
+		KeyLabel:           nat.ModelDNat44.StripKeyPrefix,
+		ValueComparator:    ctx.EquivalentDNAT44,
+		Validate:           ctx.Validate,
+		Create:             ctx.Create,
+		Delete:             ctx.Delete,
+		Update:             ctx.Update,
+		Retrieve:           ctx.Retrieve,
+		Dependencies:       ctx.Dependencies,
+		IsRetriableFailure: ctx.IsRetriableFailure,
 		// retrieve interfaces and allocated IP addresses first
 		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName, vpp_ifdescriptor.DHCPDescriptorName},
 	}
@@ -98,7 +112,7 @@ func (d *DNAT44Descriptor) EquivalentDNAT44(key string, oldDNAT, newDNAT *nat.DN
 
 // IsRetriableFailure returns <false> for errors related to invalid configuration.
 func (d *DNAT44Descriptor) IsRetriableFailure(err error) bool {
-	return err != ErrDNAT44WithEmptyLabel
+	return err != ErrDNAT44WithEmptyLabel && err != ErrDNAT44RequiresEDMode
 }
 
 // Validate validates VPP destination-NAT44 configuration.
@@ -127,6 +141,20 @@ func (d *DNAT44Descriptor) Update(key string, oldDNAT, newDNAT *nat.DNat44, oldM
 	obsoleteIDMappings, newIDMappings := diffIdentityMappings(oldDNAT.IdMappings, newDNAT.IdMappings)
 	obsoleteStMappings, newStMappings := diffStaticMappings(oldDNAT.StMappings, newDNAT.StMappings)
 
+	// check that the new mappings are supported in the current NAT44 mode
+	if requiresEDMode(newStMappings) {
+		isED, err := d.natHandler.Nat44IsEndpointDependent()
+		if err != nil {
+			err = errors.Errorf("failed to get NAT44 mode: %v", err)
+			d.log.Error(err)
+			return nil, err
+		}
+		if !isED {
+			d.log.Errorf("DNAT %s: %v", newDNAT.Label, ErrDNAT44RequiresEDMode)
+			return nil, ErrDNAT44RequiresEDMode
+		}
+	}
+
 	// remove obsolete identity mappings
 	for _, oldMapping := range obsoleteIDMappings {
 		if err = d.natHandler.DelNat44IdentityMapping(oldMapping, oldDNAT.Label); err != nil {
@@ -145,6 +173,11 @@ func (d *DNAT44Descriptor) Update(key string, oldDNAT, newDNAT *nat.DNat44, oldM
 		}
 	}
 
+	// remove sessions of obsolete mappings (best-effort, sessions would time out eventually)
+	if d.purgeSessions && (len(obsoleteIDMappings) > 0 || len(obsoleteStMappings) > 0) {
+		d.purgeMappingSessions(oldDNAT.Label, obsoleteIDMappings, obsoleteStMappings)
+	}
+
 	// add new identity mappings
 	for _, newMapping := range newIDMappings {
 		if err = d.natHandler.AddNat44IdentityMapping(newMapping, newDNAT.Label); err != nil {
@@ -166,6 +199,47 @@ func (d *DNAT44Descriptor) Update(key string, oldDNAT, newDNAT *nat.DNat44, oldM
 	return nil, nil
 }
 
+// purgeMappingSessions removes NAT44 sessions created for the given (removed) mappings.
+// Failures are only logged, sessions which could not be removed will time out.
+func (d *DNAT44Descriptor) purgeMappingSessions(dnatLabel string,
+	idMappings []*nat.DNat44_IdentityMapping, stMappings []*nat.DNat44_StaticMapping) (purged int) {
+
+	sessions, err := d.natHandler.Nat44SessionsDump()
+	if err != nil {
+		d.log.Warnf("failed to dump NAT44 sessions of DNAT %s: %v", dnatLabel, err)
+		return 0
+	}
+
+	for _, session := range sessions {
+		var obsolete bool
+		for _, mapping := range stMappings {
+			if sessionOfStaticMapping(session, mapping) {
+				obsolete = true
+				break
+			}
+		}
+		for _, mapping := range idMappings {
+			if obsolete {
+				break
+			}
+			obsolete = sessionOfIdentityMapping(session, mapping)
+		}
+		if !obsolete {
+			continue
+		}
+		if err = d.natHandler.DelNat44Session(session); err != nil {
+			d.log.Warnf("failed to remove NAT44 session %s:%d of DNAT %s: %v",
+				session.InsideIP, session.InsidePort, dnatLabel, err)
+			continue
+		}
+		purged++
+	}
+	if purged > 0 {
+		d.log.Infof("Purged %d NAT44 session(s) of removed mappings from DNAT %s", purged, dnatLabel)
+	}
+	return purged
+}
+
 // Retrieve returns the current NAT44 global configuration.
 func (d *DNAT44Descriptor) Retrieve(correlate []adapter.DNAT44KVWithMetadata) (
 	retrieved []adapter.DNAT44KVWithMetadata, err error,
@@ -216,11 +290,17 @@ func (d *DNAT44Descriptor) Retrieve(correlate []adapter.DNAT44KVWithMetadata) (
 }
 
 // Dependencies lists external interfaces and non-zero VRFs from mappings as dependencies.
+// Mappings with (self-)twice-NAT enabled additionally require an address pool
+// enabled for twice-NAT.
 func (d *DNAT44Descriptor) Dependencies(key string, dnat *nat.DNat44) (dependencies []kvs.Dependency) {
 	// collect referenced external interfaces and VRFs
 	externalIfaces := make(map[string]struct{})
 	vrfs := make(map[uint32]struct{})
+	var twiceNat bool
 	for _, mapping := range dnat.StMappings {
+		if mapping.TwiceNat != nat.DNat44_StaticMapping_DISABLED {
+			twiceNat = true
+		}
 		if mapping.ExternalInterface != "" {
 			externalIfaces[mapping.ExternalInterface] = struct{}{}
 		}
@@ -252,6 +332,15 @@ func (d *DNAT44Descriptor) Dependencies(key string, dnat *nat.DNat44) (dependenc
 			Key:   l3.VrfTableKey(vrf, l3.VrfTable_IPV4),
 		})
 	}
+	// twice-NAT translates source address using the twice-NAT pool
+	if twiceNat {
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: twiceNATPoolDep,
+			AnyOf: kvs.AnyOfDependency{
+				KeyPrefixes: []string{nat.TwiceNAT44PoolKeyPrefix},
+			},
+		})
+	}
 
 	return dependencies
 }
@@ -318,12 +407,64 @@ func diffStaticMappings(
 	return obsoleteMappings, newMappings
 }
 
+// requiresEDMode returns true if any of the given static mappings can be configured
+// only with NAT44 running in the endpoint-dependent mode.
+func requiresEDMode(stMappings []*nat.DNat44_StaticMapping) bool {
+	for _, mapping := range stMappings {
+		if mapping.TwiceNat != nat.DNat44_StaticMapping_DISABLED || len(mapping.LocalIps) > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// sessionOfStaticMapping returns true if the given NAT44 session was created
+// for the static mapping.
+func sessionOfStaticMapping(session *vppcalls.Nat44Session, mapping *nat.DNat44_StaticMapping) bool {
+	if mapping.ExternalIp == "" {
+		// the external IP is taken from the external interface, only sessions
+		// created by static mappings can be attributed to the mapping
+		if !session.IsStatic {
+			return false
+		}
+	} else if session.OutsideIP != mapping.ExternalIp {
+		return false
+	}
+	if mapping.ExternalPort != 0 &&
+		(session.OutsidePort != uint16(mapping.ExternalPort) || session.Protocol != mapping.Protocol) {
+		return false
+	}
+	for _, localIP := range mapping.LocalIps {
+		if session.InsideIP == localIP.LocalIp && session.VrfID == localIP.VrfId &&
+			(localIP.LocalPort == 0 || session.InsidePort == uint16(localIP.LocalPort)) {
+			return true
+		}
+	}
+	return false
+}
+
+// sessionOfIdentityMapping returns true if the given NAT44 session was created
+// for the identity mapping.
+func sessionOfIdentityMapping(session *vppcalls.Nat44Session, mapping *nat.DNat44_IdentityMapping) bool {
+	if mapping.IpAddress == "" || session.InsideIP != mapping.IpAddress || session.VrfID != mapping.VrfId {
+		return false
+	}
+	return mapping.Port == 0 ||
+		(session.InsidePort == uint16(mapping.Port) && session.Protocol == mapping.Protocol)
+}
+
 // equivalentStaticMappings compares two static mappings for equality.
 func equivalentStaticMappings(stMapping1, stMapping2 *nat.DNat44_StaticMapping) bool {
 	// attributes compared as usually
 	if stMapping1.Protocol != stMapping2.Protocol || stMapping1.ExternalPort != stMapping2.ExternalPort ||
 		stMapping1.ExternalIp != stMapping2.ExternalIp || stMapping1.ExternalInterface != stMapping2.ExternalInterface ||
-		stMapping1.TwiceNat != stMapping2.TwiceNat || stMapping1.SessionAffinity != stMapping1.SessionAffinity {
+		stMapping1.TwiceNat != stMapping2.TwiceNat {
+		return false
+	}
+
+	// session affinity is applied (and dumped) only for load-balanced mappings,
+	// VPP reports it as 0 for mappings with a single local IP
+	if len(stMapping1.LocalIps) > 1 && stMapping1.SessionAffinity != stMapping2.SessionAffinity {
 		return false
 	}
 
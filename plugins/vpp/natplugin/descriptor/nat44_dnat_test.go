@@ -0,0 +1,388 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// sessionNatHandler mocks NAT44 session dump and removal (and removal of identity
+// mappings), other methods of the handler are not used by the tests.
+type sessionNatHandler struct {
+	vppcalls.NatVppAPI
+	sessions []*vppcalls.Nat44Session
+	dumpErr  error
+	delErr   map[string]error // by inside IP
+	deleted  []*vppcalls.Nat44Session
+}
+
+func (h *sessionNatHandler) Nat44SessionsDump() ([]*vppcalls.Nat44Session, error) {
+	return h.sessions, h.dumpErr
+}
+
+func (h *sessionNatHandler) DelNat44IdentityMapping(mapping *nat.DNat44_IdentityMapping, dnatLabel string) error {
+	return nil
+}
+
+func (h *sessionNatHandler) DelNat44Session(session *vppcalls.Nat44Session) error {
+	if err := h.delErr[session.InsideIP]; err != nil {
+		return err
+	}
+	h.deleted = append(h.deleted, session)
+	return nil
+}
+
+func TestSessionOfStaticMapping(t *testing.T) {
+	mapping := &nat.DNat44_StaticMapping{
+		ExternalIp:   "80.80.80.80",
+		ExternalPort: 8080,
+		Protocol:     nat.DNat44_TCP,
+		LocalIps: []*nat.DNat44_StaticMapping_LocalIP{
+			{LocalIp: "10.0.0.1", LocalPort: 80, VrfId: 1},
+			{LocalIp: "10.0.0.2", LocalPort: 80, VrfId: 1},
+		},
+	}
+	extIfMapping := &nat.DNat44_StaticMapping{
+		ExternalInterface: "if0",
+		ExternalPort:      8080,
+		Protocol:          nat.DNat44_TCP,
+		LocalIps: []*nat.DNat44_StaticMapping_LocalIP{
+			{LocalIp: "10.0.0.1", LocalPort: 80},
+		},
+	}
+	session := func(insideIP string, insidePort uint16, outsideIP string, outsidePort uint16, vrf uint32,
+		proto nat.DNat44_Protocol, isStatic bool) *vppcalls.Nat44Session {
+		return &vppcalls.Nat44Session{
+			InsideIP:    insideIP,
+			InsidePort:  insidePort,
+			OutsideIP:   outsideIP,
+			OutsidePort: outsidePort,
+			VrfID:       vrf,
+			Protocol:    proto,
+			IsStatic:    isStatic,
+		}
+	}
+	tests := []struct {
+		name    string
+		mapping *nat.DNat44_StaticMapping
+		session *vppcalls.Nat44Session
+		matches bool
+	}{
+		{
+			name:    "first local",
+			mapping: mapping,
+			session: session("10.0.0.1", 80, "80.80.80.80", 8080, 1, nat.DNat44_TCP, true),
+			matches: true,
+		},
+		{
+			name:    "second local",
+			mapping: mapping,
+			session: session("10.0.0.2", 80, "80.80.80.80", 8080, 1, nat.DNat44_TCP, true),
+			matches: true,
+		},
+		{
+			name:    "different external IP",
+			mapping: mapping,
+			session: session("10.0.0.1", 80, "80.80.80.81", 8080, 1, nat.DNat44_TCP, true),
+		},
+		{
+			name:    "different external port",
+			mapping: mapping,
+			session: session("10.0.0.1", 80, "80.80.80.80", 8081, 1, nat.DNat44_TCP, true),
+		},
+		{
+			name:    "different protocol",
+			mapping: mapping,
+			session: session("10.0.0.1", 80, "80.80.80.80", 8080, 1, nat.DNat44_UDP, true),
+		},
+		{
+			name:    "different local port",
+			mapping: mapping,
+			session: session("10.0.0.1", 81, "80.80.80.80", 8080, 1, nat.DNat44_TCP, true),
+		},
+		{
+			name:    "different VRF",
+			mapping: mapping,
+			session: session("10.0.0.1", 80, "80.80.80.80", 8080, 2, nat.DNat44_TCP, true),
+		},
+		{
+			name:    "unknown local",
+			mapping: mapping,
+			session: session("10.0.0.3", 80, "80.80.80.80", 8080, 1, nat.DNat44_TCP, true),
+		},
+		{
+			name:    "external interface with static session",
+			mapping: extIfMapping,
+			session: session("10.0.0.1", 80, "192.168.1.1", 8080, 0, nat.DNat44_TCP, true),
+			matches: true,
+		},
+		{
+			name:    "external interface with dynamic session",
+			mapping: extIfMapping,
+			session: session("10.0.0.1", 80, "192.168.1.1", 8080, 0, nat.DNat44_TCP, false),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(sessionOfStaticMapping(test.session, test.mapping)).To(Equal(test.matches))
+		})
+	}
+}
+
+func TestSessionOfIdentityMapping(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping *nat.DNat44_IdentityMapping
+		session *vppcalls.Nat44Session
+		matches bool
+	}{
+		{
+			name:    "any port",
+			mapping: &nat.DNat44_IdentityMapping{IpAddress: "10.0.0.1", VrfId: 1},
+			session: &vppcalls.Nat44Session{InsideIP: "10.0.0.1", InsidePort: 22, VrfID: 1},
+			matches: true,
+		},
+		{
+			name:    "same port and protocol",
+			mapping: &nat.DNat44_IdentityMapping{IpAddress: "10.0.0.1", Port: 22, Protocol: nat.DNat44_TCP},
+			session: &vppcalls.Nat44Session{InsideIP: "10.0.0.1", InsidePort: 22, Protocol: nat.DNat44_TCP},
+			matches: true,
+		},
+		{
+			name:    "different port",
+			mapping: &nat.DNat44_IdentityMapping{IpAddress: "10.0.0.1", Port: 22, Protocol: nat.DNat44_TCP},
+			session: &vppcalls.Nat44Session{InsideIP: "10.0.0.1", InsidePort: 23, Protocol: nat.DNat44_TCP},
+		},
+		{
+			name:    "different protocol",
+			mapping: &nat.DNat44_IdentityMapping{IpAddress: "10.0.0.1", Port: 22, Protocol: nat.DNat44_TCP},
+			session: &vppcalls.Nat44Session{InsideIP: "10.0.0.1", InsidePort: 22, Protocol: nat.DNat44_UDP},
+		},
+		{
+			name:    "different VRF",
+			mapping: &nat.DNat44_IdentityMapping{IpAddress: "10.0.0.1", VrfId: 1},
+			session: &vppcalls.Nat44Session{InsideIP: "10.0.0.1", VrfID: 2},
+		},
+		{
+			name:    "interface mapping",
+			mapping: &nat.DNat44_IdentityMapping{Interface: "if0"},
+			session: &vppcalls.Nat44Session{InsideIP: "10.0.0.1"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(sessionOfIdentityMapping(test.session, test.mapping)).To(Equal(test.matches))
+		})
+	}
+}
+
+func TestPurgeMappingSessions(t *testing.T) {
+	stMappings := []*nat.DNat44_StaticMapping{
+		{
+			ExternalIp: "80.80.80.80",
+			LocalIps: []*nat.DNat44_StaticMapping_LocalIP{
+				{LocalIp: "10.0.0.1"},
+			},
+		},
+	}
+	idMappings := []*nat.DNat44_IdentityMapping{
+		{IpAddress: "10.0.0.2"},
+	}
+	sessions := []*vppcalls.Nat44Session{
+		{InsideIP: "10.0.0.1", OutsideIP: "80.80.80.80", IsStatic: true},
+		{InsideIP: "10.0.0.2", OutsideIP: "10.0.0.2", IsStatic: true},
+		{InsideIP: "10.0.0.3", OutsideIP: "80.80.80.80"},
+	}
+	tests := []struct {
+		name          string
+		handler       *sessionNatHandler
+		expectDeleted []string
+	}{
+		{
+			name:          "purge sessions of removed mappings",
+			handler:       &sessionNatHandler{sessions: sessions},
+			expectDeleted: []string{"10.0.0.1", "10.0.0.2"},
+		},
+		{
+			name: "failed removal is skipped",
+			handler: &sessionNatHandler{
+				sessions: sessions,
+				delErr:   map[string]error{"10.0.0.1": errors.New("session not found")},
+			},
+			expectDeleted: []string{"10.0.0.2"},
+		},
+		{
+			name:    "failed dump",
+			handler: &sessionNatHandler{dumpErr: errors.New("dump failed")},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			d := &DNAT44Descriptor{
+				log:           logrus.DefaultLogger(),
+				natHandler:    test.handler,
+				purgeSessions: true,
+			}
+			purged := d.purgeMappingSessions("dnat1", idMappings, stMappings)
+			Expect(purged).To(Equal(len(test.expectDeleted)))
+			var deleted []string
+			for _, session := range test.handler.deleted {
+				deleted = append(deleted, session.InsideIP)
+			}
+			Expect(deleted).To(Equal(test.expectDeleted))
+		})
+	}
+}
+
+func TestDNAT44UpdateIgnoresPurgeFailure(t *testing.T) {
+	RegisterTestingT(t)
+	handler := &sessionNatHandler{dumpErr: errors.New("dump failed")}
+	d := &DNAT44Descriptor{
+		log:           logrus.DefaultLogger(),
+		natHandler:    handler,
+		purgeSessions: true,
+	}
+	// the identity mapping is removed, nothing is added
+	oldDNAT := &nat.DNat44{
+		Label:      "dnat1",
+		IdMappings: []*nat.DNat44_IdentityMapping{{IpAddress: "10.0.0.2"}},
+	}
+	newDNAT := &nat.DNat44{Label: "dnat1"}
+
+	_, err := d.Update("key", oldDNAT, newDNAT, nil)
+	Expect(err).ToNot(HaveOccurred())
+}
+
+// nonEDNatHandler reports NAT44 running in the simple (non endpoint-dependent) mode,
+// other methods of the handler are not used by the tests.
+type nonEDNatHandler struct {
+	vppcalls.NatVppAPI
+}
+
+func (h *nonEDNatHandler) Nat44IsEndpointDependent() (bool, error) {
+	return false, nil
+}
+
+func TestDNAT44UpdateRequiresEDMode(t *testing.T) {
+	localIP := func(ip string) *nat.DNat44_StaticMapping_LocalIP {
+		return &nat.DNat44_StaticMapping_LocalIP{LocalIp: ip, LocalPort: 80, Probability: 50}
+	}
+	tests := []struct {
+		name      string
+		stMapping *nat.DNat44_StaticMapping
+	}{
+		{
+			name: "twice-NAT",
+			stMapping: &nat.DNat44_StaticMapping{
+				ExternalIp:   "80.80.80.80",
+				ExternalPort: 8080,
+				Protocol:     nat.DNat44_TCP,
+				LocalIps:     []*nat.DNat44_StaticMapping_LocalIP{localIP("10.0.0.1")},
+				TwiceNat:     nat.DNat44_StaticMapping_ENABLED,
+			},
+		},
+		{
+			name: "load-balanced",
+			stMapping: &nat.DNat44_StaticMapping{
+				ExternalIp:   "80.80.80.80",
+				ExternalPort: 8080,
+				Protocol:     nat.DNat44_TCP,
+				LocalIps:     []*nat.DNat44_StaticMapping_LocalIP{localIP("10.0.0.1"), localIP("10.0.0.2")},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			descr := NewDNAT44Descriptor(&nonEDNatHandler{}, false, logging.ForPlugin("natplugin"))
+			oldDNAT := &nat.DNat44{Label: "dnat1"}
+			newDNAT := &nat.DNat44{
+				Label:      "dnat1",
+				StMappings: []*nat.DNat44_StaticMapping{test.stMapping},
+			}
+
+			_, err := descr.Update(nat.DNAT44Key(newDNAT.Label), oldDNAT, newDNAT, nil)
+			Expect(err).To(Equal(ErrDNAT44RequiresEDMode))
+			Expect(descr.IsRetriableFailure).ToNot(BeNil())
+			Expect(descr.IsRetriableFailure(err)).To(BeFalse())
+		})
+	}
+}
+
+func TestEquivalentDNAT44SessionAffinity(t *testing.T) {
+	dnat := func(affinity uint32, localIPs ...string) *nat.DNat44 {
+		stMapping := &nat.DNat44_StaticMapping{
+			ExternalIp:      "80.80.80.80",
+			ExternalPort:    8080,
+			Protocol:        nat.DNat44_TCP,
+			SessionAffinity: affinity,
+		}
+		for _, localIP := range localIPs {
+			stMapping.LocalIps = append(stMapping.LocalIps,
+				&nat.DNat44_StaticMapping_LocalIP{LocalIp: localIP, LocalPort: 80, Probability: 50})
+		}
+		return &nat.DNat44{
+			Label:      "dnat1",
+			StMappings: []*nat.DNat44_StaticMapping{stMapping},
+		}
+	}
+	tests := []struct {
+		name       string
+		oldDNAT    *nat.DNat44
+		newDNAT    *nat.DNat44
+		equivalent bool
+	}{
+		{
+			name:       "unchanged affinity",
+			oldDNAT:    dnat(30, "10.0.0.1", "10.0.0.2"),
+			newDNAT:    dnat(30, "10.0.0.1", "10.0.0.2"),
+			equivalent: true,
+		},
+		{
+			name:    "changed affinity with load-balancing",
+			oldDNAT: dnat(30, "10.0.0.1", "10.0.0.2"),
+			newDNAT: dnat(60, "10.0.0.1", "10.0.0.2"),
+		},
+		{
+			name:    "affinity disabled with load-balancing",
+			oldDNAT: dnat(30, "10.0.0.1", "10.0.0.2"),
+			newDNAT: dnat(0, "10.0.0.1", "10.0.0.2"),
+		},
+		{
+			name:       "affinity ignored with single local (as dumped)",
+			oldDNAT:    dnat(30, "10.0.0.1"),
+			newDNAT:    dnat(0, "10.0.0.1"),
+			equivalent: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			d := &DNAT44Descriptor{log: logrus.DefaultLogger()}
+			Expect(d.EquivalentDNAT44("key", test.oldDNAT, test.newDNAT)).To(Equal(test.equivalent))
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"net"
 
 	"github.com/golang/protobuf/proto"
+	prototypes "github.com/golang/protobuf/ptypes/empty"
 	"go.ligato.io/cn-infra/v2/logging"
 
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
@@ -67,6 +68,7 @@ func NewNAT44GlobalAddressDescriptor(natHandler vppcalls.NatVppAPI, log logging.
 		Create:        ctx.Create,
 		Delete:        ctx.Delete,
 		Dependencies:  ctx.Dependencies,
+		DerivedValues: ctx.DerivedValues,
 	}
 	return adapter.NewNAT44GlobalAddressDescriptor(typedDescr)
 }
@@ -119,3 +121,15 @@ func (d *NAT44GlobalAddressDescriptor) Dependencies(key string, natAddr *nat.Nat
 		},
 	}
 }
+
+// DerivedValues derives empty value under the twice-NAT pool key for addresses
+// enabled for twice-NAT (for DNAT mappings to depend on).
+func (d *NAT44GlobalAddressDescriptor) DerivedValues(key string, natAddr *nat.Nat44Global_Address) (derValues []kvs.KeyValuePair) {
+	if natAddr.TwiceNat {
+		derValues = append(derValues, kvs.KeyValuePair{
+			Key:   nat.DerivedTwiceNAT44PoolKey(natAddr.VrfId, natAddr.Address, ""),
+			Value: &prototypes.Empty{},
+		})
+	}
+	return derValues
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2019 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"errors"
+
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT66InterfaceDescriptorName is the name of the descriptor for VPP NAT66 features applied to interfaces.
+	NAT66InterfaceDescriptorName = "vpp-nat66-interface"
+)
+
+// A list of non-retriable errors:
+var (
+	// errNAT66InsideAndOutside is returned when NAT66 is requested to be enabled
+	// on both the inside and the outside of the same interface.
+	errNAT66InsideAndOutside = errors.New("NAT66 cannot be enabled on both inside and outside of the same interface")
+)
+
+// NAT66InterfaceDescriptor teaches KVScheduler how to configure VPP NAT66 interface features.
+type NAT66InterfaceDescriptor struct {
+	log        logging.Logger
+	natHandler vppcalls.NatVppAPI
+}
+
+// NewNAT66InterfaceDescriptor creates a new instance of the NAT66Interface descriptor.
+func NewNAT66InterfaceDescriptor(natHandler vppcalls.NatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT66InterfaceDescriptor{
+		natHandler: natHandler,
+		log:        log.NewLogger("nat66-iface-descriptor"),
+	}
+
+	typedDescr := &adapter.NAT66InterfaceDescriptor{
+		Name:          NAT66InterfaceDescriptorName,
+		NBKeyPrefix:   nat.ModelNat66Interface.KeyPrefix(),
+		ValueTypeName: nat.ModelNat66Interface.ProtoName(),
+		KeySelector:   nat.ModelNat66Interface.IsKeyValid,
+		KeyLabel:      nat.ModelNat66Interface.StripKeyPrefix,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+		Dependencies:  ctx.Dependencies,
+	}
+	return adapter.NewNAT66InterfaceDescriptor(typedDescr)
+}
+
+// Validate validates NAT66 interface configuration.
+func (d *NAT66InterfaceDescriptor) Validate(key string, natIface *nat.Nat66Interface) error {
+	if natIface.NatInside && natIface.NatOutside {
+		// VPP keeps a single NAT66 record per interface, enabling the other side fails
+		return kvs.NewInvalidValueError(errNAT66InsideAndOutside, "nat_inside", "nat_outside")
+	}
+	return nil
+}
+
+// Create enables NAT66 on an interface.
+func (d *NAT66InterfaceDescriptor) Create(key string, natIface *nat.Nat66Interface) (metadata interface{}, err error) {
+	if natIface.NatInside {
+		err = d.natHandler.EnableNat66Interface(natIface.Name, true)
+		if err != nil {
+			return
+		}
+	}
+	if natIface.NatOutside {
+		err = d.natHandler.EnableNat66Interface(natIface.Name, false)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Delete disables NAT66 on an interface.
+func (d *NAT66InterfaceDescriptor) Delete(key string, natIface *nat.Nat66Interface, metadata interface{}) (err error) {
+	if natIface.NatInside {
+		err = d.natHandler.DisableNat66Interface(natIface.Name, true)
+		if err != nil {
+			return
+		}
+	}
+	if natIface.NatOutside {
+		err = d.natHandler.DisableNat66Interface(natIface.Name, false)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Retrieve returns the current NAT66 interface configuration.
+func (d *NAT66InterfaceDescriptor) Retrieve(correlate []adapter.NAT66InterfaceKVWithMetadata) (
+	retrieved []adapter.NAT66InterfaceKVWithMetadata, err error) {
+	natIfs, err := d.natHandler.Nat66InterfacesDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, natIf := range natIfs {
+		retrieved = append(retrieved, adapter.NAT66InterfaceKVWithMetadata{
+			Key:    nat.Nat66InterfaceKey(natIf.Name),
+			Value:  natIf,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists the interface as the only dependency.
+func (d *NAT66InterfaceDescriptor) Dependencies(key string, natIface *nat.Nat66Interface) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: natInterfaceDep,
+			Key:   interfaces.InterfaceKey(natIface.Name),
+		},
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+func TestNAT66InterfaceValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		natIface    *nat.Nat66Interface
+		expectedErr error
+	}{
+		{
+			name:     "inside",
+			natIface: &nat.Nat66Interface{Name: "if1", NatInside: true},
+		},
+		{
+			name:     "outside",
+			natIface: &nat.Nat66Interface{Name: "if1", NatOutside: true},
+		},
+		{
+			name:        "inside and outside",
+			natIface:    &nat.Nat66Interface{Name: "if1", NatInside: true, NatOutside: true},
+			expectedErr: errNAT66InsideAndOutside,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			d := &NAT66InterfaceDescriptor{}
+			err := d.Validate(nat.Nat66InterfaceKey(test.natIface.Name), test.natIface)
+			if test.expectedErr == nil {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*kvs.InvalidValueError).GetValidationError()).To(Equal(test.expectedErr))
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2019 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"errors"
+	"net"
+
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT66StaticMappingDescriptorName is the name of the descriptor for NAT66 static mappings.
+	NAT66StaticMappingDescriptorName = "vpp-nat66-static-mapping"
+)
+
+// A list of non-retriable errors:
+var (
+	// errInvalidIPv6Address is returned when NAT66 mapping address is not a valid IPv6 address.
+	errInvalidIPv6Address = errors.New("invalid IPv6 address")
+
+	// errNonCanonicalIPv6Address is returned when NAT66 mapping address is not in the
+	// canonical (RFC 5952) form. VPP dump returns addresses in this form, so the key
+	// and the value of the mapping would not match the retrieved ones otherwise.
+	errNonCanonicalIPv6Address = errors.New("IPv6 address is not in the canonical form")
+)
+
+// NAT66StaticMappingDescriptor teaches KVScheduler how to add/remove VPP NAT66 static mappings.
+type NAT66StaticMappingDescriptor struct {
+	log        logging.Logger
+	natHandler vppcalls.NatVppAPI
+}
+
+// NewNAT66StaticMappingDescriptor creates a new instance of the NAT66StaticMappingDescriptor.
+func NewNAT66StaticMappingDescriptor(natHandler vppcalls.NatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT66StaticMappingDescriptor{
+		natHandler: natHandler,
+		log:        log.NewLogger("nat66-static-mapping-descriptor"),
+	}
+	typedDescr := &adapter.NAT66StaticMappingDescriptor{
+		Name:          NAT66StaticMappingDescriptorName,
+		NBKeyPrefix:   nat.ModelNat66StaticMapping.KeyPrefix(),
+		ValueTypeName: nat.ModelNat66StaticMapping.ProtoName(),
+		KeySelector:   nat.ModelNat66StaticMapping.IsKeyValid,
+		KeyLabel:      nat.ModelNat66StaticMapping.StripKeyPrefix,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+		Dependencies:  ctx.Dependencies,
+	}
+	return adapter.NewNAT66StaticMappingDescriptor(typedDescr)
+}
+
+// Validate validates configuration of NAT66 static mapping.
+func (d *NAT66StaticMappingDescriptor) Validate(key string, mapping *nat.Nat66StaticMapping) error {
+	if !isIPv6(mapping.LocalIp) {
+		return kvs.NewInvalidValueError(errInvalidIPv6Address, "local_ip")
+	}
+	if net.ParseIP(mapping.LocalIp).String() != mapping.LocalIp {
+		return kvs.NewInvalidValueError(errNonCanonicalIPv6Address, "local_ip")
+	}
+	if !isIPv6(mapping.ExternalIp) {
+		return kvs.NewInvalidValueError(errInvalidIPv6Address, "external_ip")
+	}
+	if net.ParseIP(mapping.ExternalIp).String() != mapping.ExternalIp {
+		return kvs.NewInvalidValueError(errNonCanonicalIPv6Address, "external_ip")
+	}
+	return nil
+}
+
+// Create adds NAT66 static mapping.
+func (d *NAT66StaticMappingDescriptor) Create(key string, mapping *nat.Nat66StaticMapping) (metadata interface{}, err error) {
+	return nil, d.natHandler.AddNat66StaticMapping(mapping)
+}
+
+// Delete removes NAT66 static mapping.
+func (d *NAT66StaticMappingDescriptor) Delete(key string, mapping *nat.Nat66StaticMapping, metadata interface{}) error {
+	return d.natHandler.DelNat66StaticMapping(mapping)
+}
+
+// Retrieve returns NAT66 static mappings configured on VPP.
+func (d *NAT66StaticMappingDescriptor) Retrieve(correlate []adapter.NAT66StaticMappingKVWithMetadata) (
+	retrieved []adapter.NAT66StaticMappingKVWithMetadata, err error) {
+	mappings, err := d.natHandler.Nat66StaticMappingsDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range mappings {
+		retrieved = append(retrieved, adapter.NAT66StaticMappingKVWithMetadata{
+			Key:    nat.Nat66StaticMappingKey(mapping.VrfId, mapping.LocalIp),
+			Value:  mapping,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists non-zero VRF as the only dependency.
+func (d *NAT66StaticMappingDescriptor) Dependencies(key string, mapping *nat.Nat66StaticMapping) []kvs.Dependency {
+	if mapping.VrfId == 0 {
+		return nil
+	}
+	return []kvs.Dependency{
+		{
+			Label: mappingVrfDep,
+			Key:   l3.VrfTableKey(mapping.VrfId, l3.VrfTable_IPV6),
+		},
+	}
+}
+
+// isIPv6 returns true if the given string is a valid IPv6 address.
+func isIPv6(ipStr string) bool {
+	ip := net.ParseIP(ipStr)
+	return ip != nil && ip.To4() == nil
+}
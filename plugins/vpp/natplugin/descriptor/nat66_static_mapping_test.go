@@ -0,0 +1,70 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+func TestNAT66StaticMappingValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		mapping     *nat.Nat66StaticMapping
+		expectedErr error
+	}{
+		{
+			name:    "canonical addresses",
+			mapping: &nat.Nat66StaticMapping{LocalIp: "2001:db8::1", ExternalIp: "2001:db8:1::1"},
+		},
+		{
+			name:        "IPv4 local address",
+			mapping:     &nat.Nat66StaticMapping{LocalIp: "10.0.0.1", ExternalIp: "2001:db8:1::1"},
+			expectedErr: errInvalidIPv6Address,
+		},
+		{
+			name:        "invalid external address",
+			mapping:     &nat.Nat66StaticMapping{LocalIp: "2001:db8::1", ExternalIp: "2001:db8:1::1::"},
+			expectedErr: errInvalidIPv6Address,
+		},
+		{
+			name:        "uncompressed local address",
+			mapping:     &nat.Nat66StaticMapping{LocalIp: "2001:db8:0:0:0:0:0:1", ExternalIp: "2001:db8:1::1"},
+			expectedErr: errNonCanonicalIPv6Address,
+		},
+		{
+			name:        "upper-case external address",
+			mapping:     &nat.Nat66StaticMapping{LocalIp: "2001:db8::1", ExternalIp: "2001:DB8:1::1"},
+			expectedErr: errNonCanonicalIPv6Address,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			d := &NAT66StaticMappingDescriptor{}
+			err := d.Validate(nat.Nat66StaticMappingKey(test.mapping.VrfId, test.mapping.LocalIp), test.mapping)
+			if test.expectedErr == nil {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*kvs.InvalidValueError).GetValidationError()).To(Equal(test.expectedErr))
+			}
+		})
+	}
+}
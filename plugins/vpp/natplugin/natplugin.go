@@ -18,6 +18,8 @@
 //go:generate descriptor-adapter --descriptor-name DNAT44 --value-type *vpp_nat.DNat44 --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name NAT44Interface --value-type *vpp_nat.Nat44Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name NAT44AddressPool --value-type *vpp_nat.Nat44AddressPool --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT66Interface --value-type *vpp_nat.Nat66Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT66StaticMapping --value-type *vpp_nat.Nat66StaticMapping --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 
 package natplugin
 
@@ -44,6 +46,8 @@ type NATPlugin struct {
 
 	// handlers
 	natHandler vppcalls.NatVppAPI
+
+	config *Config
 }
 
 // Deps lists dependencies of the NAT plugin.
@@ -62,6 +66,10 @@ func (p *NATPlugin) Init() (err error) {
 		return nil
 	}
 
+	if p.config, err = p.loadConfig(); err != nil {
+		return err
+	}
+
 	// init handlers
 	p.natHandler = vppcalls.CompatibleNatVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.IfPlugin.GetDHCPIndex(), p.Log)
 	if p.natHandler == nil {
@@ -72,9 +80,11 @@ func (p *NATPlugin) Init() (err error) {
 	nat44GlobalCtx, nat44GlobalDescriptor := descriptor.NewNAT44GlobalDescriptor(p.natHandler, p.Log)
 	nat44GlobalIfaceDescriptor := descriptor.NewNAT44GlobalInterfaceDescriptor(p.natHandler, p.Log)
 	nat44GlobalAddrDescriptor := descriptor.NewNAT44GlobalAddressDescriptor(p.natHandler, p.Log)
-	dnat44Descriptor := descriptor.NewDNAT44Descriptor(p.natHandler, p.Log)
+	dnat44Descriptor := descriptor.NewDNAT44Descriptor(p.natHandler, p.config.PurgeStaleSessions, p.Log)
 	nat44IfaceDescriptor := descriptor.NewNAT44InterfaceDescriptor(nat44GlobalCtx, p.natHandler, p.Log)
 	nat44AddrPoolDescriptor := descriptor.NewNAT44AddressPoolDescriptor(nat44GlobalCtx, p.natHandler, p.Log)
+	nat66IfaceDescriptor := descriptor.NewNAT66InterfaceDescriptor(p.natHandler, p.Log)
+	nat66StaticMappingDescriptor := descriptor.NewNAT66StaticMappingDescriptor(p.natHandler, p.Log)
 
 	err = p.KVScheduler.RegisterKVDescriptor(
 		nat44GlobalDescriptor,
@@ -83,6 +93,8 @@ func (p *NATPlugin) Init() (err error) {
 		dnat44Descriptor,
 		nat44IfaceDescriptor,
 		nat44AddrPoolDescriptor,
+		nat66IfaceDescriptor,
+		nat66StaticMappingDescriptor,
 	)
 	if err != nil {
 		return err
@@ -15,6 +15,7 @@
 package natplugin
 
 import (
+	"go.ligato.io/cn-infra/v2/config"
 	"go.ligato.io/cn-infra/v2/health/statuscheck"
 	"go.ligato.io/cn-infra/v2/logging"
 
@@ -43,6 +44,9 @@ func NewPlugin(opts ...Option) *NATPlugin {
 	if p.Log == nil {
 		p.Log = logging.ForPlugin(p.String())
 	}
+	if p.Cfg == nil {
+		p.Cfg = config.ForPlugin(p.String())
+	}
 
 	return p
 }
@@ -50,6 +50,16 @@ type NatVppAPI interface {
 	AddNat44StaticMapping(mapping *nat.DNat44_StaticMapping, dnatLabel string) error
 	// DelNat44StaticMapping removes existing NAT44 static mapping entry.
 	DelNat44StaticMapping(mapping *nat.DNat44_StaticMapping, dnatLabel string) error
+	// DelNat44Session removes NAT44 session (given by its inside endpoint).
+	DelNat44Session(session *Nat44Session) error
+	// EnableNat66Interface enables NAT66 feature for provided interface.
+	EnableNat66Interface(iface string, isInside bool) error
+	// DisableNat66Interface disables NAT66 feature for provided interface.
+	DisableNat66Interface(iface string, isInside bool) error
+	// AddNat66StaticMapping creates new NAT66 static mapping entry.
+	AddNat66StaticMapping(mapping *nat.Nat66StaticMapping) error
+	// DelNat66StaticMapping removes existing NAT66 static mapping entry.
+	DelNat66StaticMapping(mapping *nat.Nat66StaticMapping) error
 }
 
 // NatVppRead provides read methods for VPP NAT configuration.
@@ -65,6 +75,28 @@ type NatVppRead interface {
 	Nat44InterfacesDump() ([]*nat.Nat44Interface, error)
 	// Nat44AddressPoolsDump dumps all configured NAT44 address pools.
 	Nat44AddressPoolsDump() ([]*nat.Nat44AddressPool, error)
+	// Nat44SessionsDump dumps NAT44 sessions of all users.
+	Nat44SessionsDump() ([]*Nat44Session, error)
+	// Nat44IsEndpointDependent returns true if NAT44 runs in the endpoint-dependent mode.
+	Nat44IsEndpointDependent() (bool, error)
+	// Nat66InterfacesDump dumps NAT66 config of all NAT66-enabled interfaces.
+	Nat66InterfacesDump() ([]*nat.Nat66Interface, error)
+	// Nat66StaticMappingsDump dumps all configured NAT66 static mappings.
+	Nat66StaticMappingsDump() ([]*nat.Nat66StaticMapping, error)
+}
+
+// Nat44Session represents NAT44 session (translation) dumped from VPP.
+type Nat44Session struct {
+	InsideIP    string
+	InsidePort  uint16
+	OutsideIP   string
+	OutsidePort uint16
+	Protocol    nat.DNat44_Protocol
+	VrfID       uint32 // inside VRF
+	IsStatic    bool
+	// external host is known only for endpoint-dependent sessions
+	ExtHostIP   string
+	ExtHostPort uint16
 }
 
 var handler = vpp.RegisterHandler(vpp.HandlerDesc{
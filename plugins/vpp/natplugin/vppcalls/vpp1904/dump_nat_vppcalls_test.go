@@ -370,6 +370,103 @@ func TestDNATDump(t *testing.T) {
 	Expect(dnat.IdMappings[1].Interface).To(BeEquivalentTo("if1"))
 }
 
+func TestNat44SessionsDump(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// users
+	ctx.MockVpp.MockReply(
+		&bin_api.Nat44UserDetails{
+			IPAddress: net.ParseIP("10.10.1.1").To4(),
+			VrfID:     1,
+		},
+		&bin_api.Nat44UserDetails{
+			IPAddress: net.ParseIP("10.10.1.2").To4(),
+		})
+	ctx.MockVpp.MockReply(&vpe.ControlPingReply{})
+
+	// sessions of the first user
+	ctx.MockVpp.MockReply(
+		&bin_api.Nat44UserSessionDetails{
+			InsideIPAddress:  net.ParseIP("10.10.1.1").To4(),
+			InsidePort:       8080,
+			OutsideIPAddress: net.ParseIP("80.80.80.1").To4(),
+			OutsidePort:      80,
+			Protocol:         6,
+			IsStatic:         1,
+		},
+		&bin_api.Nat44UserSessionDetails{
+			InsideIPAddress:  net.ParseIP("10.10.1.1").To4(),
+			InsidePort:       5000,
+			OutsideIPAddress: net.ParseIP("80.80.80.1").To4(),
+			OutsidePort:      1024,
+			Protocol:         17,
+			ExtHostValid:     1,
+			ExtHostAddress:   net.ParseIP("8.8.8.8").To4(),
+			ExtHostPort:      53,
+		})
+	ctx.MockVpp.MockReply(&vpe.ControlPingReply{})
+
+	// sessions of the second user
+	ctx.MockVpp.MockReply(
+		&bin_api.Nat44UserSessionDetails{
+			InsideIPAddress:  net.ParseIP("10.10.1.2").To4(),
+			InsidePort:       22,
+			OutsideIPAddress: net.ParseIP("80.80.80.1").To4(),
+			OutsidePort:      2222,
+			Protocol:         6,
+		})
+	ctx.MockVpp.MockReply(&vpe.ControlPingReply{})
+
+	sessions, err := natHandler.Nat44SessionsDump()
+	Expect(err).To(Succeed())
+	Expect(sessions).To(HaveLen(3))
+
+	Expect(sessions[0]).To(Equal(&vppcalls.Nat44Session{
+		InsideIP:    "10.10.1.1",
+		InsidePort:  8080,
+		OutsideIP:   "80.80.80.1",
+		OutsidePort: 80,
+		Protocol:    nat.DNat44_TCP,
+		VrfID:       1,
+		IsStatic:    true,
+	}))
+	Expect(sessions[1]).To(Equal(&vppcalls.Nat44Session{
+		InsideIP:    "10.10.1.1",
+		InsidePort:  5000,
+		OutsideIP:   "80.80.80.1",
+		OutsidePort: 1024,
+		Protocol:    nat.DNat44_UDP,
+		VrfID:       1,
+		ExtHostIP:   "8.8.8.8",
+		ExtHostPort: 53,
+	}))
+	Expect(sessions[2]).To(Equal(&vppcalls.Nat44Session{
+		InsideIP:    "10.10.1.2",
+		InsidePort:  22,
+		OutsideIP:   "80.80.80.1",
+		OutsidePort: 2222,
+		Protocol:    nat.DNat44_TCP,
+	}))
+}
+
+func TestNat44IsEndpointDependent(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&bin_api.NatShowConfigReply{
+		EndpointDependent: 1,
+	})
+	isED, err := natHandler.Nat44IsEndpointDependent()
+	Expect(err).To(Succeed())
+	Expect(isED).To(BeTrue())
+
+	ctx.MockVpp.MockReply(&bin_api.NatShowConfigReply{})
+	isED, err = natHandler.Nat44IsEndpointDependent()
+	Expect(err).To(Succeed())
+	Expect(isED).To(BeFalse())
+}
+
 func natTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.NatVppAPI, ifaceidx.IfaceMetadataIndexRW, idxmap.NamedMappingRW) {
 	ctx := vppmock.SetupTestCtx(t)
 	log := logrus.NewLogger("test-log")
@@ -20,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 
 	natba "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp1904/nat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
 	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 )
 
@@ -119,6 +120,39 @@ func (h *NatVppHandler) DelNat44StaticMapping(mapping *nat.DNat44_StaticMapping,
 	return h.handleNat44StaticMappingLb(mapping, dnatLabel, false)
 }
 
+// DelNat44Session removes NAT44 session (given by its inside endpoint).
+func (h *NatVppHandler) DelNat44Session(session *vppcalls.Nat44Session) error {
+	inAddr := net.ParseIP(session.InsideIP).To4()
+	if inAddr == nil {
+		return errors.Errorf("cannot delete NAT44 session: unable to parse inside IP %s", session.InsideIP)
+	}
+	var extHostAddr net.IP
+	if session.ExtHostIP != "" {
+		if extHostAddr = net.ParseIP(session.ExtHostIP).To4(); extHostAddr == nil {
+			return errors.Errorf("cannot delete NAT44 session: unable to parse external host IP %s",
+				session.ExtHostIP)
+		}
+	}
+
+	req := &natba.Nat44DelSession{
+		IsIn:           1,
+		Address:        inAddr,
+		Protocol:       h.protocolNBValueToNumber(session.Protocol),
+		Port:           session.InsidePort,
+		VrfID:          session.VrfID,
+		ExtHostValid:   boolToUint(extHostAddr != nil),
+		ExtHostAddress: extHostAddr,
+		ExtHostPort:    session.ExtHostPort,
+	}
+	reply := &natba.Nat44DelSessionReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Calls VPP binary API to set/unset interface NAT44 feature.
 func (h *NatVppHandler) handleNat44Interface(iface string, isInside, isAdd bool) error {
 	// get interface metadata
@@ -23,6 +23,7 @@ import (
 
 	binapi "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp1904/nat"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
 	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 )
 
@@ -588,6 +589,7 @@ func TestAddNat44StaticMappingLb(t *testing.T) {
 		ExternalInterface: "if0",
 		Protocol:          nat.DNat44_TCP,
 		TwiceNat:          nat.DNat44_StaticMapping_ENABLED,
+		SessionAffinity:   30,
 		LocalIps:          localIPs(localIP1, localIP2),
 	}
 
@@ -604,6 +606,7 @@ func TestAddNat44StaticMappingLb(t *testing.T) {
 	Expect(msg.ExternalAddr).To(BeEquivalentTo(externalIP))
 	Expect(msg.ExternalPort).To(BeEquivalentTo(8080))
 	Expect(msg.Protocol).To(BeEquivalentTo(6))
+	Expect(msg.Affinity).To(BeEquivalentTo(30))
 	Expect(msg.Out2inOnly).To(BeEquivalentTo(1))
 
 	// Local IPs
@@ -883,3 +886,63 @@ func localIPs(addr1, addr2 net.IP) []*nat.DNat44_StaticMapping_LocalIP {
 		},
 	}
 }
+
+func TestDelNat44Session(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&binapi.Nat44DelSessionReply{})
+	err := natHandler.DelNat44Session(&vppcalls.Nat44Session{
+		InsideIP:    "10.10.1.1",
+		InsidePort:  5000,
+		OutsideIP:   "80.80.80.1",
+		OutsidePort: 1024,
+		Protocol:    nat.DNat44_UDP,
+		VrfID:       1,
+		ExtHostIP:   "8.8.8.8",
+		ExtHostPort: 53,
+	})
+
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*binapi.Nat44DelSession)
+	Expect(ok).To(BeTrue())
+	Expect(msg).ToNot(BeNil())
+	Expect(msg.IsIn).To(BeEquivalentTo(1))
+	Expect(msg.Address).To(BeEquivalentTo(net.ParseIP("10.10.1.1").To4()))
+	Expect(msg.ExtHostValid).To(BeEquivalentTo(1))
+	Expect(msg.ExtHostAddress).To(BeEquivalentTo(net.ParseIP("8.8.8.8").To4()))
+	Expect(msg.Port).To(BeEquivalentTo(5000))
+	Expect(msg.Protocol).To(BeEquivalentTo(17))
+	Expect(msg.VrfID).To(BeEquivalentTo(1))
+	Expect(msg.ExtHostPort).To(BeEquivalentTo(53))
+}
+
+func TestDelNat44SessionError(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// Incorrect reply object
+	ctx.MockVpp.MockReply(&binapi.Nat44AddDelStaticMappingReply{})
+	err := natHandler.DelNat44Session(&vppcalls.Nat44Session{
+		InsideIP:   "10.10.1.1",
+		InsidePort: 8080,
+		Protocol:   nat.DNat44_TCP,
+	})
+
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDelNat44SessionInvalidIP(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&binapi.Nat44DelSessionReply{})
+	err := natHandler.DelNat44Session(&vppcalls.Nat44Session{
+		InsideIP:   "2001::1",
+		InsidePort: 8080,
+		Protocol:   nat.DNat44_TCP,
+	})
+
+	Expect(err).Should(HaveOccurred())
+}
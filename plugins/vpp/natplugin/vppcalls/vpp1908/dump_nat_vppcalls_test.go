@@ -449,6 +449,103 @@ func TestDNATDump(t *testing.T) {
 	Expect(dnat.IdMappings[1].Interface).To(BeEquivalentTo("if1"))
 }
 
+func TestNat44SessionsDump(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// users
+	ctx.MockVpp.MockReply(
+		&bin_api.Nat44UserDetails{
+			IPAddress: ipTo4Address("10.10.1.1"),
+			VrfID:     1,
+		},
+		&bin_api.Nat44UserDetails{
+			IPAddress: ipTo4Address("10.10.1.2"),
+		})
+	ctx.MockVpp.MockReply(&vpe.ControlPingReply{})
+
+	// sessions of the first user
+	ctx.MockVpp.MockReply(
+		&bin_api.Nat44UserSessionDetails{
+			InsideIPAddress:  ipTo4Address("10.10.1.1"),
+			InsidePort:       8080,
+			OutsideIPAddress: ipTo4Address("80.80.80.1"),
+			OutsidePort:      80,
+			Protocol:         6,
+			Flags:            bin_api.NAT_IS_STATIC,
+		},
+		&bin_api.Nat44UserSessionDetails{
+			InsideIPAddress:  ipTo4Address("10.10.1.1"),
+			InsidePort:       5000,
+			OutsideIPAddress: ipTo4Address("80.80.80.1"),
+			OutsidePort:      1024,
+			Protocol:         17,
+			Flags:            bin_api.NAT_IS_EXT_HOST_VALID,
+			ExtHostAddress:   ipTo4Address("8.8.8.8"),
+			ExtHostPort:      53,
+		})
+	ctx.MockVpp.MockReply(&vpe.ControlPingReply{})
+
+	// sessions of the second user
+	ctx.MockVpp.MockReply(
+		&bin_api.Nat44UserSessionDetails{
+			InsideIPAddress:  ipTo4Address("10.10.1.2"),
+			InsidePort:       22,
+			OutsideIPAddress: ipTo4Address("80.80.80.1"),
+			OutsidePort:      2222,
+			Protocol:         6,
+		})
+	ctx.MockVpp.MockReply(&vpe.ControlPingReply{})
+
+	sessions, err := natHandler.Nat44SessionsDump()
+	Expect(err).To(Succeed())
+	Expect(sessions).To(HaveLen(3))
+
+	Expect(sessions[0]).To(Equal(&vppcalls.Nat44Session{
+		InsideIP:    "10.10.1.1",
+		InsidePort:  8080,
+		OutsideIP:   "80.80.80.1",
+		OutsidePort: 80,
+		Protocol:    vpp_nat.DNat44_TCP,
+		VrfID:       1,
+		IsStatic:    true,
+	}))
+	Expect(sessions[1]).To(Equal(&vppcalls.Nat44Session{
+		InsideIP:    "10.10.1.1",
+		InsidePort:  5000,
+		OutsideIP:   "80.80.80.1",
+		OutsidePort: 1024,
+		Protocol:    vpp_nat.DNat44_UDP,
+		VrfID:       1,
+		ExtHostIP:   "8.8.8.8",
+		ExtHostPort: 53,
+	}))
+	Expect(sessions[2]).To(Equal(&vppcalls.Nat44Session{
+		InsideIP:    "10.10.1.2",
+		InsidePort:  22,
+		OutsideIP:   "80.80.80.1",
+		OutsidePort: 2222,
+		Protocol:    vpp_nat.DNat44_TCP,
+	}))
+}
+
+func TestNat44IsEndpointDependent(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&bin_api.NatShowConfigReply{
+		EndpointDependent: true,
+	})
+	isED, err := natHandler.Nat44IsEndpointDependent()
+	Expect(err).To(Succeed())
+	Expect(isED).To(BeTrue())
+
+	ctx.MockVpp.MockReply(&bin_api.NatShowConfigReply{})
+	isED, err = natHandler.Nat44IsEndpointDependent()
+	Expect(err).To(Succeed())
+	Expect(isED).To(BeFalse())
+}
+
 func natTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.NatVppAPI, ifaceidx.IfaceMetadataIndexRW, idxmap.NamedMappingRW) {
 	ctx := vppmock.SetupTestCtx(t)
 	log := logrus.NewLogger("test-log")
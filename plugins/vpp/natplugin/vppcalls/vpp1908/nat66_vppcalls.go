@@ -0,0 +1,168 @@
+//  Copyright (c) 2019 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp1908
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+
+	natba "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp1908/nat"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// EnableNat66Interface enables NAT66 feature for provided interface.
+func (h *NatVppHandler) EnableNat66Interface(iface string, isInside bool) error {
+	return h.handleNat66Interface(iface, isInside, true)
+}
+
+// DisableNat66Interface disables NAT66 feature for provided interface.
+func (h *NatVppHandler) DisableNat66Interface(iface string, isInside bool) error {
+	return h.handleNat66Interface(iface, isInside, false)
+}
+
+// AddNat66StaticMapping creates new NAT66 static mapping entry.
+func (h *NatVppHandler) AddNat66StaticMapping(mapping *nat.Nat66StaticMapping) error {
+	return h.handleNat66StaticMapping(mapping, true)
+}
+
+// DelNat66StaticMapping removes existing NAT66 static mapping entry.
+func (h *NatVppHandler) DelNat66StaticMapping(mapping *nat.Nat66StaticMapping) error {
+	return h.handleNat66StaticMapping(mapping, false)
+}
+
+// Nat66InterfacesDump dumps NAT66 config of all NAT66-enabled interfaces.
+func (h *NatVppHandler) Nat66InterfacesDump() (natIfs []*nat.Nat66Interface, err error) {
+	byName := make(map[string]*nat.Nat66Interface)
+
+	req := &natba.Nat66InterfaceDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+	for {
+		msg := &natba.Nat66InterfaceDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT66 interface: %v", err)
+		}
+		if stop {
+			break
+		}
+		ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(msg.SwIfIndex))
+		if !found {
+			h.log.Warnf("Interface with index %d not found in the mapping", msg.SwIfIndex)
+			continue
+		}
+		natIf, ok := byName[ifName]
+		if !ok {
+			natIf = &nat.Nat66Interface{Name: ifName}
+			byName[ifName] = natIf
+			natIfs = append(natIfs, natIf)
+		}
+		if msg.Flags&natba.NAT_IS_INSIDE != 0 {
+			natIf.NatInside = true
+		} else {
+			natIf.NatOutside = true
+		}
+	}
+	return
+}
+
+// Nat66StaticMappingsDump dumps all configured NAT66 static mappings.
+func (h *NatVppHandler) Nat66StaticMappingsDump() (mappings []*nat.Nat66StaticMapping, err error) {
+	req := &natba.Nat66StaticMappingDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+	for {
+		msg := &natba.Nat66StaticMappingDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT66 static mapping: %v", err)
+		}
+		if stop {
+			break
+		}
+		mappings = append(mappings, &nat.Nat66StaticMapping{
+			LocalIp:    net.IP(msg.LocalIPAddress[:]).String(),
+			ExternalIp: net.IP(msg.ExternalIPAddress[:]).String(),
+			VrfId:      msg.VrfID,
+		})
+	}
+	return
+}
+
+// Calls VPP binary API to set/unset interface NAT66 feature.
+func (h *NatVppHandler) handleNat66Interface(iface string, isInside, isAdd bool) error {
+	// get interface metadata
+	ifaceMeta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return errors.New("failed to get interface metadata")
+	}
+
+	var flags natba.NatConfigFlags
+	if isInside {
+		flags = natba.NAT_IS_INSIDE
+	}
+	req := &natba.Nat66AddDelInterface{
+		SwIfIndex: natba.InterfaceIndex(ifaceMeta.SwIfIndex),
+		Flags:     flags,
+		IsAdd:     isAdd,
+	}
+	reply := &natba.Nat66AddDelInterfaceReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Calls VPP binary API to add/remove NAT66 static mapping.
+func (h *NatVppHandler) handleNat66StaticMapping(mapping *nat.Nat66StaticMapping, isAdd bool) error {
+	localIP, err := ipTo6Address(mapping.LocalIp)
+	if err != nil {
+		return errors.Errorf("cannot configure NAT66 static mapping: unable to parse local IP %s: %v",
+			mapping.LocalIp, err)
+	}
+	externalIP, err := ipTo6Address(mapping.ExternalIp)
+	if err != nil {
+		return errors.Errorf("cannot configure NAT66 static mapping: unable to parse external IP %s: %v",
+			mapping.ExternalIp, err)
+	}
+
+	req := &natba.Nat66AddDelStaticMapping{
+		LocalIPAddress:    localIP,
+		ExternalIPAddress: externalIP,
+		VrfID:             mapping.VrfId,
+		IsAdd:             isAdd,
+	}
+	reply := &natba.Nat66AddDelStaticMappingReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func ipTo6Address(ipStr string) (addr natba.IP6Address, err error) {
+	netIP := net.ParseIP(ipStr)
+	if netIP == nil {
+		return natba.IP6Address{}, fmt.Errorf("invalid IP: %q", ipStr)
+	}
+	if netIP.To4() != nil {
+		return natba.IP6Address{}, fmt.Errorf("required IPv6, provided: %q", ipStr)
+	}
+	copy(addr[:], netIP.To16())
+	return
+}
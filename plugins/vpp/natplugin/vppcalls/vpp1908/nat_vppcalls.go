@@ -21,6 +21,7 @@ import (
 	"github.com/pkg/errors"
 
 	natba "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp1908/nat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
 	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 )
 
@@ -132,6 +133,41 @@ func (h *NatVppHandler) DelNat44StaticMapping(mapping *nat.DNat44_StaticMapping,
 	return h.handleNat44StaticMappingLb(mapping, dnatLabel, false)
 }
 
+// DelNat44Session removes NAT44 session (given by its inside endpoint).
+func (h *NatVppHandler) DelNat44Session(session *vppcalls.Nat44Session) error {
+	inAddr, err := ipTo4Address(session.InsideIP)
+	if err != nil {
+		return errors.Errorf("cannot delete NAT44 session: unable to parse inside IP %s: %v",
+			session.InsideIP, err)
+	}
+	flags := &nat44Flags{isInside: true}
+	var extHostAddr natba.IP4Address
+	if session.ExtHostIP != "" {
+		if extHostAddr, err = ipTo4Address(session.ExtHostIP); err != nil {
+			return errors.Errorf("cannot delete NAT44 session: unable to parse external host IP %s: %v",
+				session.ExtHostIP, err)
+		}
+		flags.isExtHostValid = true
+	}
+
+	req := &natba.Nat44DelSession{
+		Address:        inAddr,
+		Protocol:       h.protocolNBValueToNumber(session.Protocol),
+		Port:           session.InsidePort,
+		VrfID:          session.VrfID,
+		Flags:          setNat44Flags(flags),
+		ExtHostAddress: extHostAddr,
+		ExtHostPort:    session.ExtHostPort,
+	}
+	reply := &natba.Nat44DelSessionReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Calls VPP binary API to set/unset interface NAT44 feature.
 func (h *NatVppHandler) handleNat44Interface(iface string, isInside, isAdd bool) error {
 	// get interface metadata
@@ -22,6 +22,7 @@ import (
 	. "github.com/onsi/gomega"
 	binapi "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp1908/nat"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls/vpp1908"
 	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 )
@@ -597,6 +598,7 @@ func TestAddNat44StaticMappingLb(t *testing.T) {
 		ExternalInterface: "if0",
 		Protocol:          vpp_nat.DNat44_TCP,
 		TwiceNat:          vpp_nat.DNat44_StaticMapping_ENABLED,
+		SessionAffinity:   30,
 		LocalIps:          localIPs(localIP1, localIP2),
 	}
 
@@ -612,6 +614,7 @@ func TestAddNat44StaticMappingLb(t *testing.T) {
 	Expect(addressTo4IP(msg.ExternalAddr)).To(BeEquivalentTo(externalIP.String()))
 	Expect(msg.ExternalPort).To(BeEquivalentTo(8080))
 	Expect(msg.Protocol).To(BeEquivalentTo(6))
+	Expect(msg.Affinity).To(BeEquivalentTo(30))
 	Expect(msg.Flags).To(BeEquivalentTo(binapi.NAT_IS_TWICE_NAT + binapi.NAT_IS_OUT2IN_ONLY))
 
 	// Local IPs
@@ -905,3 +908,62 @@ func addressTo4IP(address binapi.IP4Address) string {
 	}
 	return ipAddr.To4().String()
 }
+
+func TestDelNat44Session(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&binapi.Nat44DelSessionReply{})
+	err := natHandler.DelNat44Session(&vppcalls.Nat44Session{
+		InsideIP:    "10.10.1.1",
+		InsidePort:  5000,
+		OutsideIP:   "80.80.80.1",
+		OutsidePort: 1024,
+		Protocol:    vpp_nat.DNat44_UDP,
+		VrfID:       1,
+		ExtHostIP:   "8.8.8.8",
+		ExtHostPort: 53,
+	})
+
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*binapi.Nat44DelSession)
+	Expect(ok).To(BeTrue())
+	Expect(msg).ToNot(BeNil())
+	Expect(msg.Flags).To(Equal(binapi.NAT_IS_INSIDE | binapi.NAT_IS_EXT_HOST_VALID))
+	Expect(msg.Address).To(Equal(ipTo4Address("10.10.1.1")))
+	Expect(msg.ExtHostAddress).To(Equal(ipTo4Address("8.8.8.8")))
+	Expect(msg.Port).To(BeEquivalentTo(5000))
+	Expect(msg.Protocol).To(BeEquivalentTo(17))
+	Expect(msg.VrfID).To(BeEquivalentTo(1))
+	Expect(msg.ExtHostPort).To(BeEquivalentTo(53))
+}
+
+func TestDelNat44SessionError(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// Incorrect reply object
+	ctx.MockVpp.MockReply(&binapi.Nat44AddDelStaticMappingReply{})
+	err := natHandler.DelNat44Session(&vppcalls.Nat44Session{
+		InsideIP:   "10.10.1.1",
+		InsidePort: 8080,
+		Protocol:   vpp_nat.DNat44_TCP,
+	})
+
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDelNat44SessionInvalidIP(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&binapi.Nat44DelSessionReply{})
+	err := natHandler.DelNat44Session(&vppcalls.Nat44Session{
+		InsideIP:   "2001::1",
+		InsidePort: 8080,
+		Protocol:   vpp_nat.DNat44_TCP,
+	})
+
+	Expect(err).Should(HaveOccurred())
+}
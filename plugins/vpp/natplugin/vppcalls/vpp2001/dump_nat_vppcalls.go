@@ -24,6 +24,7 @@ import (
 
 	vpp_nat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2001/nat"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
 	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 )
@@ -215,6 +216,71 @@ func (h *NatVppHandler) Nat44AddressPoolsDump() (natPools []*nat.Nat44AddressPoo
 	return
 }
 
+// Nat44SessionsDump dumps NAT44 sessions of all users.
+func (h *NatVppHandler) Nat44SessionsDump() (sessions []*vppcalls.Nat44Session, err error) {
+	var users []*vpp_nat.Nat44UserDetails
+	req := &vpp_nat.Nat44UserDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat.Nat44UserDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT44 users: %v", err)
+		}
+		if stop {
+			break
+		}
+		users = append(users, msg)
+	}
+
+	for _, user := range users {
+		req := &vpp_nat.Nat44UserSessionDump{
+			IPAddress: user.IPAddress,
+			VrfID:     user.VrfID,
+		}
+		reqContext := h.callsChannel.SendMultiRequest(req)
+
+		for {
+			msg := &vpp_nat.Nat44UserSessionDetails{}
+			stop, err := reqContext.ReceiveReply(msg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to dump NAT44 user sessions: %v", err)
+			}
+			if stop {
+				break
+			}
+			flags := getNat44Flags(msg.Flags)
+			session := &vppcalls.Nat44Session{
+				InsideIP:    net.IP(msg.InsideIPAddress[:]).String(),
+				InsidePort:  msg.InsidePort,
+				OutsideIP:   net.IP(msg.OutsideIPAddress[:]).String(),
+				OutsidePort: msg.OutsidePort,
+				Protocol:    h.protocolNumberToNBValue(uint8(msg.Protocol)),
+				VrfID:       user.VrfID,
+				IsStatic:    flags.isStatic,
+			}
+			if flags.isExtHostValid {
+				session.ExtHostIP = net.IP(msg.ExtHostAddress[:]).String()
+				session.ExtHostPort = msg.ExtHostPort
+			}
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions, nil
+}
+
+// Nat44IsEndpointDependent returns true if NAT44 runs in the endpoint-dependent mode.
+func (h *NatVppHandler) Nat44IsEndpointDependent() (bool, error) {
+	req := &vpp_nat.NatShowConfig{}
+	reply := &vpp_nat.NatShowConfigReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return false, fmt.Errorf("failed to dump NAT config: %v", err)
+	}
+	return reply.EndpointDependent, nil
+}
+
 // nat44AddressDump returns NAT44 address pool configured in the VPP.
 // Deprecated. Functionality moved to Nat44AddressPoolsDump. Kept for backward compatibility.
 func (h *NatVppHandler) nat44AddressDump() (addressPool []*nat.Nat44Global_Address, err error) {
@@ -0,0 +1,183 @@
+//  Copyright (c) 2019 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2001_test
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_nat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2001/nat"
+	vpp_vpe "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2001/vpe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+func TestEnableNat66InterfaceAsInside(t *testing.T) {
+	ctx, natHandler, swIfIndexes, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_nat.Nat66AddDelInterfaceReply{})
+	err := natHandler.EnableNat66Interface("if0", true)
+
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat.Nat66AddDelInterface)
+	Expect(ok).To(BeTrue())
+	Expect(msg).ToNot(BeNil())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.Flags).To(BeEquivalentTo(vpp_nat.NAT_IS_INSIDE))
+	Expect(msg.SwIfIndex).To(BeEquivalentTo(1))
+}
+
+func TestDisableNat66InterfaceAsOutside(t *testing.T) {
+	ctx, natHandler, swIfIndexes, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	swIfIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(&vpp_nat.Nat66AddDelInterfaceReply{})
+	err := natHandler.DisableNat66Interface("if1", false)
+
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat.Nat66AddDelInterface)
+	Expect(ok).To(BeTrue())
+	Expect(msg).ToNot(BeNil())
+	Expect(msg.IsAdd).To(BeFalse())
+	Expect(msg.Flags).To(BeEquivalentTo(0))
+	Expect(msg.SwIfIndex).To(BeEquivalentTo(2))
+}
+
+func TestEnableNat66InterfaceError(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// interface "if0" is not registered
+	ctx.MockVpp.MockReply(&vpp_nat.Nat66AddDelInterfaceReply{})
+	err := natHandler.EnableNat66Interface("if0", true)
+
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestAddNat66StaticMapping(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat.Nat66AddDelStaticMappingReply{})
+	err := natHandler.AddNat66StaticMapping(&nat.Nat66StaticMapping{
+		LocalIp:    "fd00::1",
+		ExternalIp: "2001:db8::1",
+		VrfId:      1,
+	})
+
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat.Nat66AddDelStaticMapping)
+	Expect(ok).To(BeTrue())
+	Expect(msg).ToNot(BeNil())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.VrfID).To(BeEquivalentTo(1))
+	Expect(net.IP(msg.LocalIPAddress[:]).String()).To(Equal("fd00::1"))
+	Expect(net.IP(msg.ExternalIPAddress[:]).String()).To(Equal("2001:db8::1"))
+}
+
+func TestAddNat66StaticMappingInvalidIP(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat.Nat66AddDelStaticMappingReply{})
+	err := natHandler.AddNat66StaticMapping(&nat.Nat66StaticMapping{
+		LocalIp:    "10.0.0.1",
+		ExternalIp: "2001:db8::1",
+	})
+
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDelNat66StaticMapping(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat.Nat66AddDelStaticMappingReply{})
+	err := natHandler.DelNat66StaticMapping(&nat.Nat66StaticMapping{
+		LocalIp:    "fd00::1",
+		ExternalIp: "2001:db8::1",
+	})
+
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat.Nat66AddDelStaticMapping)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeFalse())
+}
+
+func TestNat66InterfacesDump(t *testing.T) {
+	ctx, natHandler, swIfIndexes, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_nat.Nat66InterfaceDetails{
+			SwIfIndex: 1,
+			Flags:     vpp_nat.NAT_IS_INSIDE,
+		},
+		&vpp_nat.Nat66InterfaceDetails{
+			SwIfIndex: 2,
+		})
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	swIfIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	interfaces, err := natHandler.Nat66InterfacesDump()
+	Expect(err).To(Succeed())
+
+	Expect(interfaces).To(HaveLen(2))
+
+	Expect(interfaces[0].Name).To(Equal("if0"))
+	Expect(interfaces[0].NatInside).To(BeTrue())
+	Expect(interfaces[0].NatOutside).To(BeFalse())
+
+	Expect(interfaces[1].Name).To(Equal("if1"))
+	Expect(interfaces[1].NatInside).To(BeFalse())
+	Expect(interfaces[1].NatOutside).To(BeTrue())
+}
+
+func TestNat66StaticMappingsDump(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	var localIP, externalIP vpp_nat.IP6Address
+	copy(localIP[:], net.ParseIP("fd00::1").To16())
+	copy(externalIP[:], net.ParseIP("2001:db8::1").To16())
+
+	ctx.MockVpp.MockReply(&vpp_nat.Nat66StaticMappingDetails{
+		LocalIPAddress:    localIP,
+		ExternalIPAddress: externalIP,
+		VrfID:             1,
+	})
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+
+	mappings, err := natHandler.Nat66StaticMappingsDump()
+	Expect(err).To(Succeed())
+
+	Expect(mappings).To(HaveLen(1))
+	Expect(mappings[0].LocalIp).To(Equal("fd00::1"))
+	Expect(mappings[0].ExternalIp).To(Equal("2001:db8::1"))
+	Expect(mappings[0].VrfId).To(BeEquivalentTo(1))
+}
@@ -22,6 +22,7 @@ import (
 	. "github.com/onsi/gomega"
 	vpp_nat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2001/nat"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls/vpp2001"
 	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 )
@@ -599,6 +600,7 @@ func TestAddNat44StaticMappingLb(t *testing.T) {
 		ExternalInterface: "if0",
 		Protocol:          nat.DNat44_TCP,
 		TwiceNat:          nat.DNat44_StaticMapping_ENABLED,
+		SessionAffinity:   30,
 		LocalIps:          localIPs(localIP1, localIP2),
 	}
 
@@ -614,6 +616,7 @@ func TestAddNat44StaticMappingLb(t *testing.T) {
 	Expect(addressTo4IP(msg.ExternalAddr)).To(BeEquivalentTo(externalIP.String()))
 	Expect(msg.ExternalPort).To(BeEquivalentTo(8080))
 	Expect(msg.Protocol).To(BeEquivalentTo(6))
+	Expect(msg.Affinity).To(BeEquivalentTo(30))
 	Expect(msg.Flags).To(BeEquivalentTo(vpp_nat.NAT_IS_TWICE_NAT + vpp_nat.NAT_IS_OUT2IN_ONLY))
 
 	// Local IPs
@@ -907,3 +910,62 @@ func addressTo4IP(address vpp_nat.IP4Address) string {
 	}
 	return ipAddr.To4().String()
 }
+
+func TestDelNat44Session(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat.Nat44DelSessionReply{})
+	err := natHandler.DelNat44Session(&vppcalls.Nat44Session{
+		InsideIP:    "10.10.1.1",
+		InsidePort:  5000,
+		OutsideIP:   "80.80.80.1",
+		OutsidePort: 1024,
+		Protocol:    nat.DNat44_UDP,
+		VrfID:       1,
+		ExtHostIP:   "8.8.8.8",
+		ExtHostPort: 53,
+	})
+
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat.Nat44DelSession)
+	Expect(ok).To(BeTrue())
+	Expect(msg).ToNot(BeNil())
+	Expect(msg.Flags).To(Equal(vpp_nat.NAT_IS_INSIDE | vpp_nat.NAT_IS_EXT_HOST_VALID))
+	Expect(msg.Address).To(Equal(ipTo4Address("10.10.1.1")))
+	Expect(msg.ExtHostAddress).To(Equal(ipTo4Address("8.8.8.8")))
+	Expect(msg.Port).To(BeEquivalentTo(5000))
+	Expect(msg.Protocol).To(BeEquivalentTo(17))
+	Expect(msg.VrfID).To(BeEquivalentTo(1))
+	Expect(msg.ExtHostPort).To(BeEquivalentTo(53))
+}
+
+func TestDelNat44SessionError(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// Incorrect reply object
+	ctx.MockVpp.MockReply(&vpp_nat.Nat44AddDelStaticMappingReply{})
+	err := natHandler.DelNat44Session(&vppcalls.Nat44Session{
+		InsideIP:   "10.10.1.1",
+		InsidePort: 8080,
+		Protocol:   nat.DNat44_TCP,
+	})
+
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDelNat44SessionInvalidIP(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat.Nat44DelSessionReply{})
+	err := natHandler.DelNat44Session(&vppcalls.Nat44Session{
+		InsideIP:   "2001::1",
+		InsidePort: 8080,
+		Protocol:   nat.DNat44_TCP,
+	})
+
+	Expect(err).Should(HaveOccurred())
+}
@@ -139,11 +139,15 @@ type HandleReplies struct {
 	Ping     bool
 	Message  govppapi.Message
 	Messages []govppapi.Message
+	// Sequence is replied one message per request (in the given order)
+	// for repeated requests of the same name, the last one is re-used
+	Sequence []govppapi.Message
 }
 
 // MockReplies sets up reply handler for give HandleReplies.
 func (ctx *TestCtx) MockReplies(dataList []*HandleReplies) {
 	var sendControlPing bool
+	seqIdx := make(map[string]int)
 
 	ctx.MockVpp.MockReplyHandler(func(request mock.MessageDTO) (reply []byte, msgID uint16, prepared bool) {
 		// Following types are not automatically stored in mock adapter's map and will be sent with empty MsgName
@@ -182,12 +186,21 @@ func (ctx *TestCtx) MockReplies(dataList []*HandleReplies) {
 					ctx.MockVpp.MockReply(dataMock.Messages...)
 					return nil, 0, false
 				}
-				if dataMock.Message == nil {
+				replyMsg := dataMock.Message
+				if len(dataMock.Sequence) > 0 {
+					idx := seqIdx[dataMock.Name]
+					if idx >= len(dataMock.Sequence) {
+						idx = len(dataMock.Sequence) - 1
+					}
+					replyMsg = dataMock.Sequence[idx]
+					seqIdx[dataMock.Name]++
+				}
+				if replyMsg == nil {
 					return nil, 0, false
 				}
-				msgID, err := ctx.MockVpp.GetMsgID(dataMock.Message.GetMessageName(), dataMock.Message.GetCrcString())
+				msgID, err := ctx.MockVpp.GetMsgID(replyMsg.GetMessageName(), replyMsg.GetCrcString())
 				Expect(err).To(BeNil())
-				reply, err := ctx.MockVpp.ReplyBytes(request, dataMock.Message)
+				reply, err := ctx.MockVpp.ReplyBytes(request, replyMsg)
 				Expect(err).To(BeNil())
 				return reply, msgID, true
 			}
@@ -380,6 +380,7 @@ type Interface struct {
 	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	// PhysAddress represents physical address (MAC) of the interface.
 	// Random address will be assigned if left empty.
+	// Use "pool" to allocate the address from the MAC pool configured for VPP ifplugin.
 	PhysAddress string `protobuf:"bytes,4,opt,name=phys_address,json=physAddress,proto3" json:"phys_address,omitempty"`
 	// IPAddresses define list of IP addresses for the interface and must be
 	// defined in the following format: <ipAddress>/<ipPrefix>.
@@ -396,6 +397,24 @@ type Interface struct {
 	Unnumbered    *Interface_Unnumbered    `protobuf:"bytes,9,opt,name=unnumbered,proto3" json:"unnumbered,omitempty"`
 	RxModes       []*Interface_RxMode      `protobuf:"bytes,12,rep,name=rx_modes,json=rxModes,proto3" json:"rx_modes,omitempty"`
 	RxPlacements  []*Interface_RxPlacement `protobuf:"bytes,13,rep,name=rx_placements,json=rxPlacements,proto3" json:"rx_placements,omitempty"`
+	// Enables IP directed broadcast on the interface (packets destined
+	// to the subnet broadcast address are sent as L2 broadcast).
+	DirectedBroadcast bool `protobuf:"varint,14,opt,name=directed_broadcast,json=directedBroadcast,proto3" json:"directed_broadcast,omitempty"`
+	// VrfIpv6 defines the ID of VRF table used for IPv6 if it should differ
+	// from the table used for IPv4. If unset (zero), <vrf> applies to both
+	// IP versions.
+	// Note that zero is not a valid override, i.e. IPv6 cannot be kept in the
+	// default table 0 while IPv4 is assigned to a non-zero <vrf>.
+	VrfIpv6 uint32 `protobuf:"varint,15,opt,name=vrf_ipv6,json=vrfIpv6,proto3" json:"vrf_ipv6,omitempty"`
+	// Ipv6Enabled enables IPv6 on the interface even without any IPv6 address
+	// configured (i.e. with only the link-local address, e.g. for ND).
+	Ipv6Enabled bool `protobuf:"varint,16,opt,name=ipv6_enabled,json=ipv6Enabled,proto3" json:"ipv6_enabled,omitempty"`
+	// DetailedStats enables collection of detailed per-interface combined
+	// counters (unicast/multicast/broadcast) exported in the stats segment.
+	// The setting cannot be dumped from VPP, it is re-applied to interfaces
+	// not configured by the agent in the current VPP run (e.g. DPDK interfaces
+	// after VPP or agent restart).
+	DetailedStats bool `protobuf:"varint,17,opt,name=detailed_stats,json=detailedStats,proto3" json:"detailed_stats,omitempty"`
 	// Link defines configuration for specific interface types.
 	// It can be nil for some interfaces types like: loopback and DPDK.
 	//
@@ -518,6 +537,34 @@ func (m *Interface) GetRxPlacements() []*Interface_RxPlacement {
 	return nil
 }
 
+func (m *Interface) GetDirectedBroadcast() bool {
+	if m != nil {
+		return m.DirectedBroadcast
+	}
+	return false
+}
+
+func (m *Interface) GetVrfIpv6() uint32 {
+	if m != nil {
+		return m.VrfIpv6
+	}
+	return 0
+}
+
+func (m *Interface) GetIpv6Enabled() bool {
+	if m != nil {
+		return m.Ipv6Enabled
+	}
+	return false
+}
+
+func (m *Interface) GetDetailedStats() bool {
+	if m != nil {
+		return m.DetailedStats
+	}
+	return false
+}
+
 type isInterface_Link interface {
 	isInterface_Link()
 }
@@ -1225,8 +1272,10 @@ type TapLink struct {
 	// Rx ring buffer size; must be power of 2; default is 256; only for TAP v.2
 	RxRingSize uint32 `protobuf:"varint,4,opt,name=rx_ring_size,json=rxRingSize,proto3" json:"rx_ring_size,omitempty"`
 	// Tx ring buffer size; must be power of 2; default is 256; only for TAP v.2
-	TxRingSize           uint32   `protobuf:"varint,5,opt,name=tx_ring_size,json=txRingSize,proto3" json:"tx_ring_size,omitempty"`
-	EnableGso            bool     `protobuf:"varint,6,opt,name=enable_gso,json=enableGso,proto3" json:"enable_gso,omitempty"`
+	TxRingSize uint32 `protobuf:"varint,5,opt,name=tx_ring_size,json=txRingSize,proto3" json:"tx_ring_size,omitempty"`
+	EnableGso  bool   `protobuf:"varint,6,opt,name=enable_gso,json=enableGso,proto3" json:"enable_gso,omitempty"`
+	// Enables checksum offload; only for TAP v.2 (supported since VPP 20.01)
+	EnableCsumOffload    bool     `protobuf:"varint,7,opt,name=enable_csum_offload,json=enableCsumOffload,proto3" json:"enable_csum_offload,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1299,6 +1348,13 @@ func (m *TapLink) GetEnableGso() bool {
 	return false
 }
 
+func (m *TapLink) GetEnableCsumOffload() bool {
+	if m != nil {
+		return m.EnableCsumOffload
+	}
+	return false
+}
+
 // IPSecLink defines configuration for interface type: IPSEC_TUNNEL
 type IPSecLink struct {
 	// Extended sequence number
@@ -1814,144 +1870,149 @@ func init() {
 }
 
 var fileDescriptor_8b053108eedee97b = []byte{
-	// 2224 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xdd, 0x72, 0xe3, 0xb6,
-	0x15, 0xb6, 0x7e, 0x2c, 0x51, 0x47, 0x3f, 0xe6, 0x62, 0x9a, 0x0c, 0xe3, 0x64, 0x1b, 0x57, 0x49,
-	0x1a, 0x4f, 0x9a, 0x78, 0x6b, 0xb9, 0xf9, 0x69, 0x33, 0xd3, 0x84, 0xb2, 0x68, 0xaf, 0x62, 0x99,
-	0x52, 0x28, 0x6a, 0x93, 0x74, 0xd2, 0xe1, 0x50, 0x22, 0x24, 0xb3, 0xa6, 0x48, 0x2e, 0x09, 0x69,
-	0xe5, 0xdc, 0xf5, 0x09, 0xda, 0x87, 0xe9, 0x7b, 0x74, 0xfa, 0x06, 0xed, 0x7d, 0x1f, 0xa0, 0xed,
-	0x55, 0xe7, 0x00, 0xa0, 0x2c, 0x25, 0x6b, 0x7b, 0x6f, 0x6c, 0xe0, 0xc3, 0xf9, 0x70, 0xc0, 0x83,
-	0xf3, 0x07, 0xc1, 0x7b, 0x81, 0x3f, 0x73, 0x59, 0xf4, 0x64, 0x19, 0xc7, 0x4f, 0xfc, 0x90, 0xd1,
-	0x64, 0xea, 0x4e, 0x68, 0x7a, 0x3b, 0x3c, 0x8a, 0x93, 0x88, 0x45, 0xe4, 0x35, 0x21, 0x76, 0xb4,
-	0x8c, 0xe3, 0xa3, 0x5b, 0xb1, 0xfd, 0xb7, 0x36, 0xd9, 0x71, 0x4a, 0x27, 0xe2, 0xaf, 0x20, 0x35,
-	0xff, 0x5c, 0x87, 0x4a, 0x37, 0x13, 0x26, 0x04, 0x8a, 0xa1, 0x3b, 0xa7, 0x5a, 0xee, 0x20, 0x77,
-	0x58, 0xb1, 0xf8, 0x98, 0xfc, 0x16, 0x8a, 0xec, 0x26, 0xa6, 0x5a, 0xfe, 0x20, 0x77, 0xd8, 0x68,
-	0xbd, 0x77, 0xf4, 0x52, 0x2d, 0x47, 0xeb, 0x3d, 0x8e, 0xec, 0x9b, 0x98, 0x5a, 0x9c, 0x42, 0x34,
-	0x28, 0xd3, 0xd0, 0x1d, 0x07, 0xd4, 0xd3, 0x0a, 0x07, 0xb9, 0x43, 0xc5, 0xca, 0xa6, 0xe4, 0x17,
-	0x50, 0x8b, 0xaf, 0x6e, 0x52, 0xc7, 0xf5, 0xbc, 0x84, 0xa6, 0xa9, 0x56, 0xe4, 0x0a, 0xab, 0x88,
-	0xe9, 0x02, 0x42, 0x11, 0x3f, 0xce, 0x04, 0x68, 0xaa, 0xed, 0x1e, 0x14, 0x50, 0xc4, 0x8f, 0xf5,
-	0x0c, 0x22, 0x2a, 0x14, 0x96, 0xc9, 0x54, 0x2b, 0x1d, 0xe4, 0x0e, 0xeb, 0x16, 0x0e, 0xc9, 0x2f,
-	0x61, 0x2f, 0xa5, 0xcc, 0xf1, 0xae, 0x26, 0xb1, 0x33, 0x09, 0x7c, 0x1a, 0x32, 0xad, 0xcc, 0x35,
-	0xd7, 0x53, 0xca, 0x3a, 0x57, 0x93, 0xf8, 0x94, 0x83, 0xc8, 0x9c, 0xb3, 0x85, 0xa6, 0x08, 0xe6,
-	0x9c, 0x2d, 0xc8, 0x05, 0xc0, 0x22, 0x0c, 0x17, 0xf3, 0x31, 0x4d, 0xa8, 0xa7, 0x55, 0x0e, 0x72,
-	0x87, 0xd5, 0xd6, 0xaf, 0x1e, 0xfc, 0xd8, 0xd1, 0x9a, 0x62, 0x6d, 0xd0, 0x49, 0x1b, 0x94, 0x64,
-	0xe5, 0xcc, 0x23, 0x8f, 0xa6, 0x5a, 0xed, 0xa0, 0x70, 0x58, 0x6d, 0xbd, 0xff, 0xe0, 0x56, 0xd6,
-	0xea, 0x32, 0xf2, 0xa8, 0x55, 0x4e, 0xf8, 0xff, 0x94, 0x7c, 0x0d, 0xf5, 0x64, 0xe5, 0xc4, 0x81,
-	0x3b, 0xa1, 0x73, 0x1a, 0xb2, 0x54, 0xab, 0xf3, 0x8d, 0x3e, 0x7c, 0x85, 0x8d, 0x06, 0x19, 0xc9,
-	0xaa, 0x25, 0xb7, 0x93, 0x94, 0x7c, 0x0a, 0x85, 0x74, 0x31, 0xd6, 0x3c, 0xfe, 0x71, 0xef, 0xdc,
-	0xb1, 0xd1, 0x70, 0x31, 0x5e, 0xef, 0xf5, 0x74, 0xc7, 0x42, 0x06, 0xf9, 0x0c, 0x76, 0xe7, 0x74,
-	0xee, 0x4f, 0x35, 0xca, 0xa9, 0x07, 0x77, 0x50, 0x2f, 0x51, 0xa6, 0xe7, 0x87, 0xd7, 0x4f, 0x77,
-	0x2c, 0x41, 0x20, 0x3a, 0x28, 0xee, 0x34, 0x76, 0x27, 0xd7, 0x94, 0x69, 0xd3, 0x7b, 0xf5, 0xea,
-	0x52, 0x4c, 0xf2, 0xd7, 0x34, 0xd2, 0x82, 0x02, 0x73, 0x63, 0x6d, 0xc6, 0xd9, 0x3f, 0xbf, 0x83,
-	0x6d, 0xbb, 0xb1, 0x24, 0xa2, 0x30, 0x1e, 0x78, 0xb9, 0x0a, 0xdc, 0x50, 0xbb, 0xba, 0xf7, 0xc0,
-	0xcf, 0x50, 0x26, 0x3b, 0x30, 0x27, 0x20, 0x93, 0xc7, 0x87, 0xe6, 0xdf, 0xcb, 0xec, 0x0e, 0x86,
-	0x74, 0x92, 0x31, 0x39, 0x81, 0x7c, 0x01, 0xca, 0x72, 0xbe, 0x72, 0x42, 0xca, 0x4e, 0xb4, 0x3f,
-	0x71, 0x72, 0xf3, 0x2e, 0xb5, 0xf3, 0x95, 0x49, 0xd9, 0x89, 0xa4, 0x97, 0x97, 0x62, 0x4a, 0x3e,
-	0x86, 0xe2, 0x38, 0x0a, 0x3d, 0xed, 0x9a, 0x93, 0xdf, 0xbe, 0x83, 0xdc, 0x8e, 0x42, 0x4f, 0x32,
-	0xb9, 0x38, 0xda, 0x67, 0x96, 0x50, 0x2d, 0xb8, 0xd7, 0x3e, 0xe7, 0x09, 0xcd, 0xec, 0x33, 0x4b,
-	0x28, 0xaa, 0x9a, 0xb1, 0x78, 0xa1, 0xcd, 0xef, 0x55, 0x75, 0xce, 0xe2, 0x45, 0xa6, 0x0a, 0xc5,
-	0xf7, 0x3f, 0x03, 0xb8, 0xf5, 0x78, 0xf2, 0x01, 0x3c, 0x5a, 0x0b, 0x3b, 0x2f, 0x7c, 0x76, 0xe5,
-	0xf8, 0xb1, 0x4c, 0x1d, 0x7b, 0xeb, 0x85, 0x6f, 0x7c, 0x76, 0xd5, 0x8d, 0xf7, 0xff, 0x9e, 0x83,
-	0x92, 0xf0, 0x70, 0xf2, 0x33, 0xd8, 0x7d, 0xbe, 0xa0, 0x0b, 0x91, 0x65, 0xea, 0x96, 0x98, 0x90,
-	0x2f, 0xa1, 0x88, 0xf1, 0x22, 0xd3, 0xcc, 0x87, 0xaf, 0x18, 0x2e, 0x32, 0xdb, 0x20, 0x13, 0x13,
-	0x86, 0x47, 0xa7, 0xee, 0x22, 0x60, 0x3c, 0xf2, 0x64, 0xca, 0xa9, 0x4a, 0x0c, 0xa5, 0x9b, 0x5f,
-	0x41, 0x11, 0x09, 0xa4, 0x0a, 0xe5, 0x91, 0x79, 0x61, 0xf6, 0xbf, 0x31, 0xd5, 0x1d, 0x9c, 0x0c,
-	0xfa, 0xbd, 0x5e, 0xd7, 0x3c, 0x57, 0x73, 0x04, 0xd3, 0xa1, 0x69, 0x1b, 0x96, 0x35, 0x1a, 0xd8,
-	0x6a, 0x9e, 0xd4, 0x40, 0xd1, 0x3b, 0xfa, 0xc0, 0xee, 0x3e, 0x33, 0xd4, 0x02, 0x4a, 0x76, 0x8c,
-	0x33, 0x7d, 0xd4, 0xb3, 0xd5, 0xe2, 0xfe, 0xf7, 0x50, 0xdd, 0x88, 0xb4, 0x3b, 0xbe, 0xea, 0x75,
-	0x28, 0xbd, 0x88, 0x92, 0x6b, 0x9a, 0xf0, 0xef, 0xaa, 0x5b, 0x72, 0x46, 0xde, 0x86, 0xea, 0xdc,
-	0xf5, 0x43, 0x87, 0x5d, 0x25, 0xd4, 0xcd, 0xb2, 0x23, 0x20, 0x64, 0x73, 0xa4, 0xf9, 0xaf, 0x9c,
-	0x3c, 0x2a, 0x81, 0xc6, 0xc8, 0xec, 0x18, 0x67, 0x5d, 0xd3, 0xe8, 0x38, 0xf6, 0x77, 0x03, 0x43,
-	0xdd, 0x21, 0x8f, 0xa0, 0x3e, 0x1c, 0xb5, 0x1d, 0x7e, 0xd0, 0x33, 0xfd, 0xd4, 0x50, 0x73, 0xe4,
-	0x35, 0x78, 0x34, 0xec, 0x9f, 0xd9, 0xdf, 0xe8, 0x96, 0xe1, 0xf4, 0xfa, 0xfd, 0x41, 0x5b, 0x3f,
-	0xbd, 0x50, 0xf3, 0x44, 0x81, 0x62, 0x67, 0xd0, 0xb9, 0x50, 0x0b, 0xa4, 0x02, 0xbb, 0x97, 0xc6,
-	0x65, 0xf7, 0x4c, 0x2d, 0x92, 0x32, 0x14, 0x6c, 0x7d, 0xa0, 0xee, 0xe2, 0xc7, 0xea, 0x67, 0xce,
-	0x40, 0x3f, 0xbd, 0x30, 0x6c, 0xb5, 0x44, 0x54, 0xa8, 0x3d, 0xfb, 0xb6, 0xa7, 0x9b, 0x8e, 0x3d,
-	0x32, 0x4d, 0xa3, 0xa7, 0x96, 0x11, 0xe9, 0x0e, 0x86, 0xc6, 0x69, 0x86, 0x28, 0xa8, 0xe7, 0xd9,
-	0xe5, 0xb7, 0xa6, 0x61, 0x9f, 0x6c, 0xa8, 0xaf, 0xe0, 0x29, 0xdb, 0x7d, 0xb3, 0xb3, 0x81, 0x01,
-	0x69, 0x00, 0x9c, 0x5b, 0x46, 0x46, 0xad, 0x92, 0x3d, 0xa8, 0x9e, 0xdb, 0x83, 0x51, 0x06, 0xd4,
-	0xda, 0x25, 0x28, 0x06, 0x7e, 0x78, 0xdd, 0xfc, 0x5f, 0x1e, 0x6a, 0x9b, 0x59, 0x07, 0xad, 0x13,
-	0xbb, 0x09, 0x0d, 0x99, 0xb3, 0x51, 0x8d, 0x40, 0x40, 0x26, 0xd6, 0xa4, 0xd7, 0xa0, 0x94, 0x2e,
-	0xc6, 0x8e, 0xef, 0x49, 0xb3, 0xee, 0xa6, 0x8b, 0x71, 0xd7, 0x23, 0xdf, 0x41, 0x9d, 0xb9, 0x33,
-	0x27, 0x79, 0xe1, 0x44, 0x31, 0xf3, 0xa3, 0x90, 0xdb, 0xb5, 0xd1, 0xfa, 0xf8, 0x15, 0x32, 0xdd,
-	0x91, 0xed, 0xce, 0x2c, 0xfa, 0x22, 0xf1, 0x19, 0xed, 0x73, 0x6e, 0x6a, 0x55, 0x99, 0x3b, 0xb3,
-	0x5e, 0x88, 0x19, 0x79, 0x0c, 0x10, 0x2f, 0xd2, 0x2b, 0xc7, 0x8b, 0xd8, 0xf1, 0x73, 0x5e, 0xae,
-	0x14, 0xab, 0x82, 0x48, 0x07, 0x01, 0x2c, 0x9c, 0xcc, 0x9d, 0x1d, 0x6b, 0xbb, 0xfc, 0x38, 0x7c,
-	0x2c, 0xb1, 0x96, 0x2c, 0x4f, 0x7c, 0xdc, 0xfc, 0x4b, 0x0e, 0x1e, 0xfd, 0x44, 0x13, 0x7a, 0x59,
-	0xa7, 0x3b, 0xd4, 0xdb, 0x3d, 0xa3, 0xa3, 0xee, 0xe0, 0x4d, 0x0d, 0x46, 0xc3, 0xa7, 0xc7, 0x6a,
-	0x2e, 0x1b, 0xb6, 0xc4, 0x4d, 0x0e, 0xfa, 0x83, 0x63, 0xb5, 0x20, 0x47, 0x2d, 0xb5, 0x88, 0x16,
-	0xb5, 0x2d, 0xdd, 0x1c, 0xf6, 0x74, 0xdb, 0x38, 0x3e, 0x56, 0x77, 0xb7, 0x81, 0x96, 0x5a, 0xda,
-	0x02, 0x5a, 0xc7, 0x6a, 0x79, 0x1b, 0x68, 0xa9, 0x4a, 0xf3, 0x9f, 0x79, 0xa8, 0xac, 0xf3, 0x36,
-	0xf9, 0xbd, 0x8c, 0xc2, 0x1c, 0x37, 0xdc, 0x07, 0x0f, 0xe5, 0x79, 0x31, 0xe2, 0x75, 0x4b, 0xc4,
-	0xe0, 0xeb, 0x50, 0x9a, 0xbb, 0x29, 0x93, 0xfe, 0xae, 0x58, 0x72, 0x46, 0x1a, 0x90, 0xf7, 0x85,
-	0x9b, 0xd7, 0xad, 0xbc, 0xef, 0x91, 0xf7, 0x61, 0x2f, 0x8d, 0x30, 0xbb, 0x3b, 0x53, 0x3f, 0xa0,
-	0xfc, 0x96, 0x45, 0x0b, 0xd0, 0x10, 0xf0, 0x99, 0x44, 0x71, 0xc3, 0x94, 0x4e, 0x12, 0xca, 0xb8,
-	0x69, 0x2b, 0x96, 0x9c, 0x91, 0x37, 0xa1, 0x92, 0xf8, 0xe1, 0xcc, 0x49, 0xfd, 0x1f, 0xa8, 0xb4,
-	0xb0, 0x82, 0xc0, 0xd0, 0xff, 0x81, 0xfb, 0xcf, 0x78, 0x31, 0x9d, 0xd2, 0x44, 0x2c, 0x97, 0xf9,
-	0x32, 0x08, 0x88, 0x0b, 0x20, 0x7b, 0xe5, 0xf0, 0x10, 0x4d, 0x65, 0x13, 0xa0, 0x24, 0xab, 0xaf,
-	0xf9, 0x1c, 0x17, 0xd9, 0x7a, 0xb1, 0x22, 0x16, 0x99, 0x5c, 0x6c, 0xb6, 0xa4, 0xb5, 0x78, 0x26,
-	0xab, 0x81, 0x62, 0xd8, 0x4f, 0x0d, 0xcb, 0x34, 0x6c, 0x75, 0x87, 0x94, 0x20, 0xdf, 0x1d, 0xa8,
-	0x39, 0x34, 0xf1, 0x60, 0x64, 0xda, 0x4e, 0xd7, 0xfc, 0xca, 0x38, 0xb5, 0xd5, 0x7c, 0xf3, 0xdf,
-	0x79, 0xa8, 0xac, 0x2b, 0x0d, 0x1e, 0x2e, 0x4d, 0x26, 0xeb, 0xce, 0x47, 0x3a, 0x77, 0x9a, 0x4c,
-	0xb2, 0xc6, 0xe7, 0x6d, 0xa8, 0x7a, 0x29, 0x5b, 0x0b, 0xe4, 0x85, 0x80, 0x97, 0xb2, 0x4c, 0x00,
-	0xdb, 0x9e, 0xd0, 0x97, 0xd6, 0xc4, 0x21, 0x79, 0x0b, 0x2a, 0xf3, 0x45, 0xc0, 0xfc, 0x89, 0x9b,
-	0x32, 0x69, 0xc8, 0x5b, 0x80, 0x7c, 0x02, 0x85, 0x59, 0x4c, 0xb9, 0x01, 0xab, 0xad, 0x77, 0x1f,
-	0x2a, 0x85, 0x47, 0xe7, 0x31, 0xb5, 0x90, 0xb0, 0xff, 0xb7, 0x1c, 0x14, 0xce, 0x63, 0x4a, 0x0e,
-	0x30, 0xb1, 0x4e, 0xdc, 0xd8, 0x59, 0x26, 0x53, 0x8c, 0x39, 0x91, 0xe1, 0x80, 0x63, 0xcf, 0x92,
-	0x69, 0xd7, 0x23, 0x5d, 0x50, 0x78, 0x3b, 0x39, 0x89, 0x02, 0x99, 0xc0, 0x3f, 0x7a, 0x15, 0x35,
-	0x47, 0x03, 0x49, 0xb2, 0xd6, 0xf4, 0xe6, 0x97, 0xa0, 0x64, 0xe8, 0x76, 0x9a, 0x2e, 0x43, 0xa1,
-	0x3b, 0xf8, 0x8d, 0x9a, 0x13, 0x83, 0x4f, 0x44, 0x72, 0x5e, 0x9b, 0xbf, 0x80, 0xb0, 0x39, 0x7c,
-	0xaa, 0x16, 0x9b, 0x7f, 0x84, 0xda, 0x66, 0x2f, 0x41, 0xde, 0x85, 0xda, 0x55, 0x94, 0x32, 0xc7,
-	0x9f, 0x6e, 0xa4, 0x93, 0x76, 0x5e, 0xcb, 0x59, 0x80, 0x78, 0x77, 0xca, 0x53, 0xca, 0xfb, 0xb0,
-	0x17, 0xf8, 0xe1, 0x62, 0xe5, 0xac, 0x0f, 0x2b, 0x2d, 0xdf, 0xe0, 0xf0, 0x3a, 0x51, 0x34, 0xff,
-	0x91, 0x83, 0xb2, 0xec, 0x36, 0xb0, 0xc1, 0x5d, 0xd2, 0x24, 0xc5, 0x54, 0x23, 0x8c, 0x92, 0x4d,
-	0xd1, 0x66, 0x5b, 0x4a, 0xe5, 0x2d, 0x6e, 0x2b, 0x64, 0x91, 0x33, 0xf7, 0x27, 0x49, 0x94, 0xd2,
-	0x64, 0xe9, 0x4f, 0x44, 0xc5, 0xaa, 0x58, 0x0d, 0x16, 0x5d, 0x6e, 0xa0, 0xb8, 0x55, 0xb2, 0x72,
-	0x6e, 0xbd, 0xbd, 0x28, 0xcc, 0x9f, 0xac, 0xac, 0xcc, 0xdf, 0x0f, 0xa0, 0xc6, 0x36, 0x25, 0x44,
-	0x16, 0x02, 0x76, 0x2b, 0xf1, 0x18, 0x40, 0xb4, 0xde, 0xce, 0x2c, 0x8d, 0x78, 0xbc, 0x28, 0x56,
-	0x45, 0x20, 0xe7, 0x69, 0xd4, 0xfc, 0x6f, 0x01, 0x2a, 0xeb, 0x8e, 0x06, 0xfd, 0x8b, 0xa6, 0xa1,
-	0x8c, 0x60, 0x1c, 0xa2, 0x4b, 0xba, 0x21, 0xf3, 0x9d, 0x84, 0xc6, 0x81, 0x7b, 0x93, 0x95, 0x2b,
-	0x84, 0x2c, 0x8e, 0x90, 0x37, 0x40, 0x09, 0xa2, 0x89, 0x1b, 0x60, 0x07, 0x20, 0xfc, 0xaf, 0xcc,
-	0xe7, 0xdd, 0x98, 0xc7, 0x1a, 0x9d, 0x47, 0x8c, 0xe2, 0x9a, 0x08, 0x62, 0x45, 0x00, 0x62, 0x51,
-	0xf0, 0xd2, 0xd8, 0xcf, 0xc2, 0x98, 0x03, 0xc3, 0xd8, 0xc7, 0x43, 0x4b, 0x26, 0xae, 0x8a, 0x28,
-	0x96, 0x7b, 0xe1, 0xf2, 0xef, 0x00, 0x26, 0xc9, 0x4d, 0xcc, 0x22, 0xc7, 0x0d, 0x66, 0x3c, 0x8a,
-	0x1b, 0xad, 0x37, 0xb7, 0xdc, 0x8e, 0xbf, 0x73, 0x4e, 0xb9, 0x8c, 0x1e, 0xcc, 0xac, 0xca, 0x24,
-	0x1b, 0x92, 0x43, 0x50, 0x85, 0x5e, 0xb9, 0xc3, 0x35, 0xbd, 0xe1, 0xa1, 0x8e, 0xd7, 0x8d, 0xb8,
-	0x20, 0x5d, 0xd0, 0x1b, 0x6c, 0x72, 0xe4, 0x21, 0x36, 0x44, 0x41, 0x34, 0x39, 0x62, 0xe1, 0x56,
-	0xf6, 0x53, 0xa8, 0xa0, 0xf7, 0xcc, 0xf8, 0x81, 0xaa, 0xfc, 0x40, 0xfb, 0x3f, 0x3d, 0x10, 0xba,
-	0xd2, 0x0c, 0xcf, 0xa3, 0xf8, 0x72, 0x84, 0xcf, 0x16, 0x69, 0x3e, 0x4e, 0x47, 0x15, 0x35, 0xae,
-	0xa2, 0x2e, 0xac, 0x88, 0x28, 0x2a, 0x38, 0x04, 0x35, 0xb3, 0xe5, 0x5a, 0xb0, 0x2e, 0x8e, 0x2d,
-	0x4d, 0xba, 0x21, 0x29, 0x2f, 0x7c, 0xe1, 0xc5, 0x0e, 0x0d, 0x27, 0x6e, 0xac, 0x35, 0xf8, 0xb5,
-	0x35, 0x04, 0x3e, 0xf2, 0x62, 0x03, 0xd1, 0xa6, 0x07, 0xd5, 0x8d, 0x7e, 0x14, 0xaf, 0x5a, 0x12,
-	0x69, 0x10, 0xcd, 0xa4, 0x13, 0x48, 0xe7, 0x31, 0x82, 0x68, 0x86, 0x57, 0x9d, 0xac, 0x9e, 0x0b,
-	0x47, 0x13, 0x29, 0xa8, 0x9c, 0xac, 0x9e, 0x73, 0x2f, 0x7b, 0x03, 0x14, 0x96, 0x2d, 0x09, 0x2f,
-	0x2d, 0x33, 0xb1, 0x84, 0x1e, 0xa6, 0x64, 0x9d, 0xab, 0xac, 0x06, 0xb9, 0x75, 0x35, 0xf8, 0x4c,
-	0x56, 0x1d, 0x51, 0xae, 0xdf, 0x7d, 0xa0, 0xf1, 0x3d, 0xda, 0xa8, 0x37, 0x9f, 0x43, 0x3e, 0x18,
-	0x73, 0x5d, 0x8d, 0x3b, 0x5f, 0x6b, 0x6b, 0x5e, 0x2f, 0x72, 0xbd, 0xb6, 0x1b, 0xb8, 0xe1, 0x84,
-	0x5a, 0xf9, 0x60, 0x4c, 0xbe, 0x87, 0x47, 0xd8, 0x40, 0x53, 0xef, 0x36, 0xe6, 0xb3, 0xe7, 0xda,
-	0x93, 0x87, 0xf6, 0x6a, 0x73, 0xe2, 0x3a, 0x2b, 0x58, 0xea, 0x78, 0x1b, 0x48, 0xf7, 0x03, 0xd8,
-	0xfb, 0x91, 0xd0, 0x4b, 0x9f, 0xd7, 0x8f, 0x01, 0xfc, 0xd4, 0x89, 0xdd, 0x34, 0xf5, 0x97, 0x54,
-	0x9a, 0xbb, 0xe2, 0xa7, 0x03, 0x01, 0xa0, 0x67, 0xf8, 0xa9, 0x13, 0x44, 0xe1, 0xcc, 0x61, 0xfe,
-	0x9c, 0x46, 0x0b, 0x26, 0xa3, 0xaf, 0xee, 0xa7, 0xbd, 0x28, 0x9c, 0xd9, 0x02, 0x6c, 0x7e, 0x07,
-	0x45, 0x5e, 0x92, 0xb6, 0x52, 0xe6, 0x1e, 0x54, 0xad, 0xfe, 0xc8, 0xec, 0x38, 0x56, 0xbf, 0xdd,
-	0x35, 0xd5, 0x1c, 0x36, 0x8e, 0xfa, 0x29, 0x36, 0xb3, 0x0e, 0xf6, 0x87, 0xa3, 0x81, 0x9a, 0xc7,
-	0xb4, 0xf9, 0x6d, 0xdf, 0x52, 0x0b, 0xd8, 0x0c, 0xb6, 0xad, 0xbe, 0xde, 0x39, 0xd5, 0x87, 0xb6,
-	0x5a, 0xc4, 0x2e, 0xa3, 0xa7, 0x9f, 0x0e, 0xd4, 0xdd, 0xe6, 0x17, 0x50, 0xdd, 0xb0, 0x1c, 0x96,
-	0xb9, 0x5e, 0x4b, 0xe4, 0xe3, 0xde, 0x89, 0xcc, 0xc7, 0xbd, 0xd6, 0x89, 0x9a, 0xc7, 0x15, 0x0b,
-	0x77, 0x2a, 0x41, 0xbe, 0x7d, 0xaa, 0x16, 0xf1, 0xbf, 0xde, 0x56, 0x77, 0x9b, 0xff, 0xc9, 0x41,
-	0x59, 0xbe, 0x3f, 0x48, 0x07, 0xaa, 0x6c, 0x11, 0x86, 0x34, 0x70, 0xf8, 0x8f, 0x0a, 0xa2, 0xcf,
-	0x78, 0xe7, 0xfe, 0x47, 0x8b, 0x68, 0xf2, 0x41, 0xf0, 0x78, 0x53, 0xfc, 0x06, 0x28, 0x59, 0x0d,
-	0x95, 0x99, 0xb5, 0x2c, 0x0b, 0x28, 0x2e, 0x65, 0xd5, 0x53, 0xe6, 0xd3, 0xb2, 0x2c, 0x9d, 0x98,
-	0x26, 0xa3, 0x05, 0xa3, 0x89, 0x33, 0xf5, 0x79, 0xef, 0x28, 0x13, 0x29, 0xc7, 0xce, 0x7c, 0x6c,
-	0x20, 0x1f, 0x03, 0xa4, 0x34, 0xc5, 0x04, 0x8e, 0xeb, 0x22, 0x8d, 0x56, 0x24, 0xd2, 0xf5, 0x9a,
-	0xbf, 0x7e, 0xd9, 0xf3, 0x01, 0xed, 0x71, 0x22, 0xcc, 0x60, 0x1b, 0x6d, 0x35, 0x4f, 0x00, 0x4a,
-	0x86, 0x35, 0x1c, 0xe8, 0xa6, 0x5a, 0x68, 0xfe, 0x35, 0x0f, 0x4a, 0xf6, 0x8a, 0xda, 0x3a, 0x75,
-	0xee, 0xee, 0x53, 0xe7, 0xb7, 0x4f, 0xbd, 0x55, 0xdb, 0x0b, 0x3f, 0xae, 0xed, 0xd8, 0x64, 0xd2,
-	0xf5, 0xb7, 0xf0, 0x31, 0x7e, 0x27, 0x0f, 0xf8, 0xac, 0x5e, 0xcb, 0x72, 0xc0, 0x31, 0x51, 0xaf,
-	0xcf, 0x41, 0x54, 0x6f, 0x27, 0xa4, 0x2b, 0xc6, 0xf3, 0x6e, 0xa3, 0x75, 0xf8, 0xc0, 0x23, 0xf0,
-	0xc8, 0xa4, 0x2b, 0x66, 0x62, 0xe8, 0x55, 0x38, 0x17, 0xa7, 0xcd, 0x63, 0x50, 0x32, 0x78, 0xf3,
-	0x75, 0xb4, 0x23, 0xbd, 0x24, 0x97, 0x55, 0xed, 0x7c, 0x56, 0xb5, 0x0b, 0xed, 0xb3, 0x3f, 0x74,
-	0x66, 0x51, 0xa6, 0xcb, 0xe7, 0xbf, 0x4b, 0x7d, 0xe4, 0xce, 0x68, 0xc8, 0x9e, 0x2c, 0x4f, 0x9e,
-	0xf0, 0x36, 0xe0, 0xc9, 0x4b, 0x7f, 0xef, 0xfa, 0x7c, 0x19, 0xc7, 0x1b, 0x81, 0x3a, 0x2e, 0x71,
-	0xd9, 0x93, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x18, 0x15, 0x6a, 0xed, 0x1e, 0x13, 0x00, 0x00,
+	// 2292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8d, 0x58, 0xdd, 0x76, 0xdb, 0xc6,
+	0x11, 0x36, 0x7f, 0x24, 0x92, 0x43, 0x91, 0xa6, 0xb6, 0x75, 0x0f, 0xaa, 0xa4, 0x8d, 0xcb, 0xd8,
+	0x8d, 0x4f, 0x12, 0x53, 0x15, 0xd5, 0x38, 0x6e, 0x73, 0x4e, 0x13, 0x90, 0x84, 0x64, 0xc6, 0x14,
+	0x89, 0x80, 0xa4, 0x6c, 0xe7, 0xa4, 0x07, 0x07, 0x22, 0x96, 0x14, 0x2a, 0x92, 0x80, 0x01, 0x50,
+	0x96, 0x7a, 0xd7, 0x27, 0x68, 0x6f, 0xfa, 0x26, 0x7d, 0x8f, 0xbe, 0x42, 0x7b, 0xdf, 0x07, 0x68,
+	0x73, 0xd5, 0x99, 0xdd, 0x05, 0x45, 0x26, 0x96, 0xe4, 0x1b, 0x69, 0xf7, 0x9b, 0x99, 0xdd, 0xc1,
+	0xec, 0xec, 0x37, 0xb3, 0x84, 0x87, 0x53, 0x6f, 0xe2, 0xc4, 0xfe, 0xee, 0x79, 0x10, 0xec, 0x7a,
+	0xf3, 0x98, 0x87, 0x63, 0x67, 0xc4, 0xa3, 0xab, 0x61, 0x2d, 0x08, 0xfd, 0xd8, 0x67, 0xf7, 0xa4,
+	0x5a, 0x0d, 0xd5, 0x6a, 0x57, 0x6a, 0x3b, 0xef, 0xaf, 0x5a, 0x07, 0x11, 0x1f, 0xc9, 0xbf, 0xd2,
+	0xa8, 0xfa, 0xf7, 0x32, 0x14, 0xda, 0x89, 0x32, 0x63, 0x90, 0x9d, 0x3b, 0x33, 0xae, 0xa5, 0xee,
+	0xa7, 0x1e, 0x15, 0x2c, 0x31, 0x66, 0xbf, 0x83, 0x6c, 0x7c, 0x19, 0x70, 0x2d, 0x8d, 0x58, 0xb9,
+	0xfe, 0xb0, 0xf6, 0xd6, 0x5d, 0x6a, 0xcb, 0x35, 0x6a, 0x03, 0x54, 0xb6, 0x84, 0x09, 0xd3, 0x20,
+	0xc7, 0xe7, 0xce, 0xc9, 0x94, 0xbb, 0x5a, 0x06, 0xad, 0xf3, 0x56, 0x32, 0x65, 0xbf, 0x82, 0xad,
+	0xe0, 0xf4, 0x32, 0xb2, 0x1d, 0xd7, 0x0d, 0x79, 0x14, 0x69, 0x59, 0xb1, 0x61, 0x91, 0x30, 0x5d,
+	0x42, 0xa4, 0xe2, 0x05, 0x89, 0x02, 0x8f, 0xb4, 0x8d, 0xfb, 0x19, 0x52, 0xf1, 0x02, 0x3d, 0x81,
+	0x58, 0x05, 0x32, 0xe7, 0xe1, 0x58, 0xdb, 0x44, 0xe3, 0x92, 0x45, 0x43, 0xf6, 0x6b, 0xb8, 0x1b,
+	0xf1, 0xd8, 0x76, 0x4f, 0x47, 0x81, 0x3d, 0x9a, 0x7a, 0x7c, 0x1e, 0x6b, 0x39, 0xb1, 0x73, 0x09,
+	0xe1, 0x16, 0xa2, 0x4d, 0x01, 0x92, 0xe5, 0x2c, 0x5e, 0x68, 0x79, 0x69, 0x89, 0x43, 0xf6, 0x1c,
+	0x60, 0x31, 0x9f, 0x2f, 0x66, 0x27, 0x3c, 0x44, 0x77, 0x0b, 0x28, 0x28, 0xd6, 0x3f, 0xb9, 0xf5,
+	0x63, 0x87, 0x4b, 0x13, 0x6b, 0xc5, 0x9c, 0x35, 0x20, 0x1f, 0x5e, 0xd8, 0x33, 0xdf, 0x45, 0xbf,
+	0xb7, 0xd0, 0xef, 0x62, 0xfd, 0xa3, 0x5b, 0x97, 0xb2, 0x2e, 0x8e, 0x50, 0xdf, 0xca, 0x85, 0xe2,
+	0x7f, 0xc4, 0xbe, 0x81, 0x12, 0xae, 0x11, 0x4c, 0x51, 0x36, 0x43, 0x97, 0x23, 0xad, 0x24, 0x16,
+	0xfa, 0xf4, 0x1d, 0x16, 0x32, 0x13, 0x23, 0x6b, 0x2b, 0xbc, 0x9a, 0x44, 0xec, 0x31, 0x30, 0xd7,
+	0x0b, 0xf9, 0x28, 0xe6, 0xae, 0x7d, 0x12, 0xfa, 0x8e, 0x3b, 0x72, 0xa2, 0x58, 0x2b, 0x8b, 0x00,
+	0x6d, 0x27, 0x92, 0x46, 0x22, 0x60, 0x3f, 0x87, 0x3c, 0xc6, 0xd4, 0xf6, 0x82, 0xf3, 0x27, 0xda,
+	0x5d, 0x11, 0xa9, 0x1c, 0xce, 0xdb, 0x38, 0x95, 0x87, 0x73, 0xfe, 0xc4, 0x4e, 0x8e, 0xb7, 0x22,
+	0xd6, 0x28, 0x12, 0x66, 0xa8, 0x23, 0x7e, 0x08, 0x65, 0x97, 0xc7, 0x8e, 0x87, 0x63, 0x3b, 0x8a,
+	0x1d, 0xfc, 0x80, 0x6d, 0x79, 0x12, 0x09, 0xda, 0x27, 0x90, 0x7d, 0x0e, 0x99, 0x68, 0x71, 0xa2,
+	0xb9, 0x22, 0xe0, 0x1f, 0x5e, 0xf3, 0x71, 0xfd, 0xc5, 0xc9, 0xf2, 0xfb, 0x9e, 0xdd, 0xb1, 0xc8,
+	0x82, 0x3d, 0x85, 0x8d, 0x19, 0x9f, 0x79, 0x63, 0x8d, 0x0b, 0xd3, 0xfb, 0xd7, 0x98, 0x1e, 0x91,
+	0x4e, 0xc7, 0x9b, 0x9f, 0xa1, 0x9d, 0x34, 0x60, 0x3a, 0xe4, 0x9d, 0x71, 0xe0, 0x8c, 0xce, 0x78,
+	0xac, 0x8d, 0x6f, 0xdc, 0x57, 0x57, 0x6a, 0xca, 0x7e, 0x69, 0xc6, 0xea, 0x90, 0x89, 0x9d, 0x40,
+	0x9b, 0x08, 0xeb, 0x5f, 0x5e, 0x63, 0x3d, 0x70, 0x02, 0x65, 0x48, 0xca, 0xe4, 0xf0, 0xf9, 0xc5,
+	0xd4, 0x99, 0x6b, 0xa7, 0x37, 0x3a, 0x7c, 0x4c, 0x3a, 0x89, 0xc3, 0xc2, 0x80, 0x2c, 0xc5, 0x9d,
+	0xd5, 0xbc, 0x1b, 0x2d, 0xdb, 0x66, 0x9f, 0x8f, 0x12, 0x4b, 0x61, 0xc0, 0xbe, 0xc4, 0x23, 0x9c,
+	0x5d, 0xd8, 0x73, 0x1e, 0xef, 0x6b, 0x7f, 0x12, 0xc6, 0xd5, 0xeb, 0xb6, 0x9d, 0x5d, 0x74, 0x51,
+	0x4b, 0x99, 0xe7, 0xce, 0xe5, 0x94, 0x7d, 0x06, 0xd9, 0x13, 0x7f, 0xee, 0x6a, 0x67, 0xc2, 0xf8,
+	0x83, 0x6b, 0x8c, 0x1b, 0xa8, 0xa2, 0x2c, 0x85, 0x3a, 0xc5, 0x67, 0x12, 0x72, 0x6d, 0x7a, 0x63,
+	0x7c, 0x0e, 0x43, 0x9e, 0xc4, 0x07, 0x95, 0x69, 0xab, 0x49, 0x1c, 0x2c, 0xb4, 0xd9, 0x8d, 0x5b,
+	0x1d, 0xa2, 0x4a, 0xb2, 0x15, 0xa9, 0xef, 0x3c, 0x05, 0xb8, 0xba, 0x85, 0xec, 0x63, 0xd8, 0x5e,
+	0x2a, 0xdb, 0x6f, 0xbc, 0xf8, 0x14, 0xd3, 0x57, 0xd1, 0xd9, 0xdd, 0xa5, 0xe0, 0x05, 0xe2, 0xed,
+	0x60, 0xe7, 0x9f, 0x29, 0xd8, 0x94, 0xb7, 0x8e, 0xfd, 0x14, 0x36, 0x5e, 0x2f, 0xf8, 0x42, 0x32,
+	0x5f, 0xc9, 0x92, 0x13, 0xf6, 0x15, 0x64, 0xe9, 0x0e, 0x2b, 0xea, 0xfb, 0xf4, 0x1d, 0xaf, 0xb0,
+	0x62, 0x40, 0xb2, 0xa4, 0x7b, 0xe2, 0xf2, 0xb1, 0xb3, 0x98, 0xc6, 0x82, 0x0d, 0x14, 0x0d, 0x16,
+	0x15, 0x46, 0xda, 0xd5, 0xaf, 0x21, 0x4b, 0x06, 0xac, 0x08, 0xb9, 0x61, 0xf7, 0x79, 0xb7, 0xf7,
+	0xa2, 0x5b, 0xb9, 0x43, 0x13, 0xb3, 0xd7, 0xe9, 0xb4, 0xbb, 0x87, 0x95, 0x14, 0x2b, 0x21, 0x45,
+	0x77, 0x07, 0x86, 0x65, 0x0d, 0xcd, 0x41, 0x25, 0xcd, 0xb6, 0x20, 0xaf, 0xb7, 0x74, 0x73, 0xd0,
+	0x3e, 0x36, 0x2a, 0x19, 0xd2, 0x6c, 0x19, 0x07, 0xfa, 0xb0, 0x33, 0xa8, 0x64, 0x77, 0xbe, 0x83,
+	0xe2, 0xca, 0xed, 0xbf, 0xe6, 0xab, 0x7e, 0x06, 0x9b, 0x6f, 0xfc, 0xf0, 0x8c, 0x87, 0xe2, 0xbb,
+	0x4a, 0x96, 0x9a, 0xb1, 0x0f, 0xa0, 0x38, 0x73, 0xbc, 0xb9, 0x1d, 0x9f, 0x86, 0xdc, 0x49, 0x18,
+	0x1b, 0x08, 0x1a, 0x08, 0xa4, 0xfa, 0xef, 0x94, 0x72, 0x95, 0x41, 0x79, 0xd8, 0xc5, 0x5d, 0xdb,
+	0x5d, 0xa3, 0x65, 0x0f, 0x5e, 0x99, 0x06, 0x7a, 0xbc, 0x0d, 0xa5, 0xfe, 0xb0, 0x61, 0x0b, 0x47,
+	0x0f, 0xf4, 0xa6, 0x81, 0x7e, 0xdf, 0x83, 0xed, 0x7e, 0xef, 0x60, 0xf0, 0x42, 0xb7, 0x0c, 0xbb,
+	0xd3, 0xeb, 0x99, 0x0d, 0xbd, 0xf9, 0x1c, 0xfd, 0xcf, 0x43, 0xb6, 0x65, 0xb6, 0x9e, 0xa3, 0xef,
+	0x05, 0xd8, 0x38, 0x32, 0x8e, 0xda, 0x07, 0x95, 0x2c, 0xcb, 0x41, 0x66, 0xa0, 0x9b, 0x95, 0x0d,
+	0xfa, 0x58, 0xfd, 0xc0, 0x36, 0x51, 0xd5, 0x18, 0x54, 0x36, 0x91, 0xa8, 0xb7, 0x8e, 0x5f, 0x76,
+	0xf4, 0xae, 0x3d, 0x18, 0x76, 0xbb, 0x46, 0xa7, 0x92, 0x23, 0x04, 0x13, 0xdd, 0x68, 0x26, 0x48,
+	0x9e, 0xf6, 0x39, 0x3e, 0x7a, 0xd9, 0x35, 0x06, 0xfb, 0x2b, 0xdb, 0x17, 0xc8, 0xcb, 0x46, 0xaf,
+	0xdb, 0x5a, 0xc1, 0x80, 0x95, 0x01, 0x0e, 0xd1, 0x1b, 0x65, 0x5a, 0x64, 0x77, 0xa1, 0x78, 0x38,
+	0x30, 0x87, 0x09, 0xb0, 0xd5, 0xd8, 0x84, 0xec, 0x14, 0xb3, 0xab, 0xfa, 0x7d, 0x1a, 0xb6, 0x56,
+	0x59, 0x87, 0xa2, 0x13, 0x38, 0x21, 0x46, 0xd5, 0x5e, 0xa9, 0x90, 0x20, 0xa1, 0x2e, 0xd5, 0xc9,
+	0x7b, 0xb0, 0x89, 0xb4, 0x64, 0x7b, 0xae, 0x0a, 0xeb, 0x06, 0xce, 0xda, 0x2e, 0x7b, 0x05, 0xa5,
+	0xd8, 0x99, 0xd8, 0xe1, 0x1b, 0xdb, 0x0f, 0x62, 0xcf, 0x9f, 0x8b, 0xb8, 0x96, 0xeb, 0x9f, 0xbd,
+	0x03, 0xd3, 0x21, 0x81, 0x4c, 0x2c, 0xfe, 0x26, 0xf4, 0x62, 0xde, 0x13, 0xb6, 0x91, 0x55, 0xc4,
+	0xb5, 0xac, 0x37, 0x72, 0xc6, 0x7e, 0x01, 0x10, 0x2c, 0xa2, 0x53, 0xdb, 0xf5, 0xe3, 0xbd, 0xd7,
+	0xa2, 0x84, 0xe6, 0xad, 0x02, 0x21, 0x2d, 0x02, 0xa8, 0x98, 0xa3, 0xf6, 0x1e, 0x16, 0x4e, 0x72,
+	0x47, 0x8c, 0x15, 0x56, 0x57, 0x25, 0x53, 0x8c, 0xab, 0x7f, 0x4d, 0xc1, 0xf6, 0x8f, 0x76, 0xa2,
+	0x2c, 0x6b, 0xb5, 0xfb, 0x7a, 0xa3, 0x63, 0xb4, 0xf0, 0x74, 0xf1, 0xa4, 0xcc, 0x61, 0xff, 0xd9,
+	0x1e, 0x9e, 0xaa, 0x1a, 0xd6, 0xe5, 0x49, 0x9a, 0x3d, 0x73, 0x0f, 0x4f, 0x52, 0x8e, 0xea, 0x78,
+	0x90, 0x18, 0xd1, 0x81, 0xa5, 0x77, 0xfb, 0x1d, 0x7d, 0x60, 0xec, 0xed, 0xe1, 0x81, 0xae, 0x01,
+	0x75, 0x3c, 0xd2, 0x55, 0xa0, 0xbe, 0x87, 0x27, 0xba, 0x06, 0xd4, 0x2b, 0xf9, 0xea, 0xbf, 0xd2,
+	0x50, 0x58, 0xf2, 0x36, 0xfb, 0x83, 0xba, 0x85, 0x29, 0x11, 0xb8, 0x8f, 0x6f, 0xe3, 0x79, 0x39,
+	0x12, 0xb5, 0x54, 0xde, 0x41, 0xcc, 0xf7, 0x19, 0x96, 0x33, 0x95, 0xef, 0x79, 0x4b, 0xcd, 0x30,
+	0x17, 0xd2, 0x9e, 0x4c, 0xf3, 0x92, 0x85, 0x23, 0xf6, 0x11, 0xf6, 0x0e, 0x3e, 0xb1, 0xbb, 0x3d,
+	0xc6, 0xf2, 0x24, 0x4e, 0x59, 0xb6, 0x25, 0x65, 0x09, 0x1f, 0x28, 0x94, 0x16, 0x44, 0x6e, 0x0d,
+	0xb1, 0x7a, 0x6c, 0x08, 0xb9, 0x9a, 0xb1, 0xf7, 0xa0, 0x10, 0x7a, 0xf3, 0x89, 0x1d, 0x79, 0x7f,
+	0xe6, 0x2a, 0xc2, 0x79, 0x02, 0xfa, 0x38, 0xa7, 0xfc, 0x39, 0x59, 0x8c, 0xc7, 0x3c, 0x94, 0xe2,
+	0x9c, 0x10, 0x83, 0x84, 0x84, 0x02, 0x59, 0x5f, 0xd8, 0xe2, 0x8a, 0x46, 0xaa, 0x31, 0xc1, 0x26,
+	0xe2, 0x1b, 0x31, 0x27, 0x61, 0xbc, 0x14, 0x16, 0xa4, 0x30, 0x56, 0xc2, 0x6a, 0x5d, 0x45, 0x4b,
+	0x30, 0x19, 0x9e, 0x9b, 0x31, 0x78, 0x66, 0x58, 0x78, 0x1d, 0xf0, 0xdc, 0x36, 0x21, 0xdd, 0x36,
+	0xf1, 0xd0, 0x30, 0xc4, 0xe6, 0xb0, 0x3b, 0xc0, 0xbb, 0xf0, 0xb5, 0xd1, 0x44, 0x12, 0xa9, 0xfe,
+	0x07, 0x43, 0xbc, 0xac, 0x34, 0xe4, 0x5c, 0x14, 0x8e, 0x96, 0xdd, 0x98, 0x4a, 0x6e, 0x84, 0x92,
+	0x66, 0x0c, 0x15, 0xdc, 0x28, 0x5e, 0x2a, 0xa4, 0xa5, 0x02, 0x42, 0x89, 0x02, 0xb5, 0x62, 0x73,
+	0x4f, 0x45, 0x93, 0x86, 0xec, 0x7d, 0x28, 0xcc, 0x90, 0xe3, 0x3c, 0xd1, 0x63, 0xc8, 0x40, 0x5e,
+	0x01, 0xec, 0x09, 0x16, 0x08, 0x6c, 0x2a, 0x37, 0x04, 0xd7, 0x3f, 0xb8, 0xad, 0x14, 0xd6, 0x0e,
+	0x91, 0x51, 0xc9, 0x60, 0xe7, 0x1f, 0x29, 0xc8, 0xe0, 0x84, 0xdd, 0x27, 0x62, 0x1d, 0x39, 0x81,
+	0x2d, 0x3a, 0x14, 0x57, 0x31, 0x1c, 0x08, 0xec, 0x18, 0x9b, 0x14, 0x97, 0xb5, 0x21, 0x2f, 0x5a,
+	0xdc, 0x91, 0x3f, 0x55, 0x04, 0xfe, 0xf8, 0x5d, 0xb6, 0xa9, 0x99, 0xca, 0xc8, 0x5a, 0x9a, 0x57,
+	0xbf, 0x82, 0x7c, 0x82, 0xae, 0xd3, 0x34, 0xb2, 0x56, 0xdb, 0xfc, 0x2d, 0xc6, 0x57, 0x0c, 0x9e,
+	0x48, 0x72, 0x5e, 0x86, 0x3f, 0x43, 0x70, 0xb7, 0xff, 0xac, 0x92, 0xad, 0xfe, 0x11, 0xb6, 0x56,
+	0x7b, 0x09, 0xf6, 0x00, 0xb6, 0x4e, 0x7d, 0x0c, 0xa8, 0x37, 0x5e, 0xa1, 0x93, 0x46, 0x5a, 0x4b,
+	0x59, 0x40, 0x78, 0x7b, 0x2c, 0x28, 0x05, 0x33, 0x12, 0xc9, 0x68, 0x71, 0x61, 0x2f, 0x9d, 0x55,
+	0x91, 0x2f, 0x0b, 0x78, 0x49, 0x14, 0xd5, 0xbf, 0xa4, 0x21, 0xa7, 0xba, 0x0d, 0x6a, 0xba, 0xcf,
+	0x79, 0x18, 0x11, 0xd5, 0xa4, 0x54, 0xd3, 0x26, 0xa7, 0x14, 0xb3, 0xb5, 0x4d, 0xd5, 0x29, 0xae,
+	0x6f, 0x18, 0xfb, 0xf6, 0xcc, 0x1b, 0x85, 0x7e, 0xc4, 0xc3, 0x73, 0x6f, 0x24, 0x2b, 0x16, 0x6e,
+	0x18, 0xfb, 0x47, 0x2b, 0x28, 0x2d, 0x85, 0xc9, 0x7a, 0x95, 0xed, 0x59, 0x19, 0xfe, 0xf0, 0xc2,
+	0x4a, 0xf2, 0x1d, 0x35, 0xe2, 0x55, 0x0d, 0xc9, 0x42, 0x10, 0x5f, 0x69, 0x20, 0x7d, 0xc9, 0xf6,
+	0xd1, 0x9e, 0x44, 0xbe, 0xb8, 0x2f, 0x48, 0x5f, 0x12, 0x39, 0x8c, 0x7c, 0x56, 0x83, 0x9f, 0x28,
+	0xf1, 0x28, 0x5a, 0xcc, 0x6c, 0x7f, 0x3c, 0x9e, 0x62, 0x63, 0xaa, 0xda, 0xf9, 0x6d, 0x29, 0x6a,
+	0xa2, 0xa4, 0x27, 0x05, 0xd5, 0xff, 0x65, 0xb0, 0x4c, 0x26, 0x1d, 0x10, 0xe5, 0x23, 0x8f, 0xe6,
+	0xea, 0xc6, 0xd3, 0x90, 0x52, 0xd8, 0x99, 0xc7, 0x9e, 0x1d, 0x72, 0xec, 0xa9, 0x2f, 0x93, 0xf2,
+	0x46, 0x90, 0x25, 0x10, 0x6a, 0x77, 0xa7, 0xfe, 0xc8, 0x99, 0x52, 0xc7, 0x20, 0xf3, 0x35, 0x27,
+	0xe6, 0xed, 0x40, 0xdc, 0x4d, 0x3e, 0xf3, 0x63, 0x4e, 0x32, 0x79, 0xe9, 0xf3, 0x12, 0x90, 0x42,
+	0x69, 0x17, 0x05, 0x5e, 0x72, 0xed, 0x05, 0xd0, 0x0f, 0x3c, 0xfa, 0x48, 0x65, 0x49, 0x52, 0x79,
+	0xeb, 0xd5, 0x5a, 0x24, 0xfe, 0x3d, 0xc0, 0x28, 0xbc, 0x0c, 0x30, 0xe8, 0xce, 0x74, 0x22, 0x6e,
+	0x7d, 0xb9, 0xfe, 0xde, 0x5a, 0x9a, 0x8a, 0xb7, 0x5a, 0x53, 0xe8, 0xe8, 0xd3, 0x89, 0x55, 0x18,
+	0x25, 0x43, 0xf6, 0x08, 0x2a, 0x72, 0x5f, 0xb5, 0xc2, 0x19, 0xbf, 0x14, 0xd4, 0x40, 0xe9, 0x41,
+	0xb8, 0x34, 0x7a, 0xce, 0x2f, 0xa9, 0x29, 0x52, 0x4e, 0xac, 0xa8, 0x82, 0x6c, 0x8a, 0xa4, 0xe0,
+	0x4a, 0xf7, 0x73, 0x28, 0x50, 0xb6, 0x4d, 0x84, 0x43, 0x45, 0xe1, 0xd0, 0xce, 0x8f, 0x1d, 0xa2,
+	0xd4, 0x9b, 0x90, 0x3f, 0x79, 0x4f, 0x8d, 0xe8, 0xe9, 0xa5, 0xc2, 0x27, 0xcc, 0x69, 0x8b, 0x2d,
+	0xb1, 0x45, 0x49, 0x46, 0x91, 0x50, 0xda, 0x00, 0xdd, 0x4e, 0x62, 0xb9, 0x54, 0x2c, 0x49, 0xb7,
+	0x55, 0x48, 0x57, 0x34, 0x55, 0x06, 0x2c, 0xdc, 0x00, 0x9f, 0x1a, 0x78, 0xb5, 0xd5, 0x63, 0xa5,
+	0x2c, 0xf1, 0xa1, 0x1b, 0x18, 0x84, 0x56, 0x5d, 0x28, 0xae, 0xf4, 0xaf, 0x74, 0xd4, 0xca, 0x90,
+	0x4f, 0xfd, 0x89, 0x4a, 0x02, 0x95, 0x6c, 0x06, 0x22, 0x74, 0xd4, 0xe1, 0xc5, 0x6b, 0x99, 0x98,
+	0x92, 0xb2, 0xf0, 0xd9, 0xf5, 0x5a, 0x64, 0x25, 0x8a, 0xe2, 0x44, 0x24, 0xb3, 0x3a, 0x17, 0x4b,
+	0x11, 0x65, 0x58, 0x3e, 0xe9, 0x74, 0x55, 0xf5, 0x48, 0x2d, 0xab, 0xc7, 0x53, 0x55, 0xa5, 0x64,
+	0x79, 0x7f, 0x70, 0x4b, 0xa3, 0x5c, 0x5b, 0xa9, 0x4f, 0x5f, 0x40, 0x7a, 0x7a, 0x22, 0xf6, 0x2a,
+	0x5f, 0xfb, 0xe2, 0x5c, 0xda, 0x75, 0x30, 0xd5, 0x1b, 0x0e, 0xf2, 0xd5, 0x88, 0x5b, 0x68, 0xc6,
+	0xbe, 0x83, 0x6d, 0x6a, 0xb8, 0xf1, 0x8d, 0x75, 0xa5, 0xad, 0x9e, 0x9c, 0xbb, 0xb7, 0xad, 0xd5,
+	0x10, 0x86, 0x4b, 0x16, 0xb1, 0x2a, 0x27, 0xeb, 0x40, 0xb4, 0x33, 0x85, 0xbb, 0x3f, 0x50, 0x7a,
+	0xeb, 0x4f, 0x04, 0x98, 0xe4, 0x5e, 0x64, 0x07, 0x4e, 0x14, 0x79, 0xe7, 0x5c, 0x85, 0xbb, 0xe0,
+	0x45, 0xa6, 0x04, 0x28, 0x33, 0x50, 0x3c, 0xf5, 0x91, 0x0a, 0x62, 0x6f, 0xc6, 0xfd, 0x45, 0xac,
+	0x6e, 0x5f, 0xc9, 0x8b, 0x3a, 0x88, 0x0e, 0x24, 0x58, 0x7d, 0x05, 0x59, 0x51, 0xc2, 0xd6, 0x28,
+	0x16, 0x2b, 0x97, 0xd5, 0xc3, 0x6e, 0xd3, 0xb6, 0x7a, 0x8d, 0x76, 0x17, 0xa9, 0x16, 0x1b, 0x4d,
+	0xbd, 0x49, 0xcd, 0xaf, 0x4d, 0xfd, 0xe4, 0xd0, 0x44, 0xd2, 0x45, 0x9a, 0x7d, 0xd9, 0xb3, 0x90,
+	0x6f, 0xb1, 0x79, 0x6c, 0x58, 0x3d, 0xbd, 0xd5, 0xd4, 0xfb, 0xd8, 0x0e, 0x53, 0x57, 0xd2, 0xd1,
+	0x9b, 0xd8, 0x55, 0x56, 0xbf, 0x84, 0xe2, 0x4a, 0xe4, 0xa8, 0x2c, 0x76, 0xea, 0x92, 0xbf, 0x3b,
+	0xfb, 0x8a, 0xbf, 0x3b, 0xf5, 0x7d, 0x5c, 0x0a, 0x25, 0x16, 0xad, 0x84, 0xff, 0x1b, 0x4d, 0x5c,
+	0x02, 0xff, 0xeb, 0x0d, 0x5c, 0xe0, 0xbf, 0x29, 0xc8, 0xa9, 0xf7, 0x0a, 0x6b, 0x41, 0x31, 0xc6,
+	0xc7, 0x3e, 0x9f, 0xda, 0xe2, 0x87, 0x11, 0xd9, 0x97, 0x7c, 0x78, 0xf3, 0x23, 0x47, 0x3e, 0x0a,
+	0x40, 0xda, 0x89, 0x26, 0x1a, 0x13, 0x2d, 0xa9, 0xb9, 0x8a, 0x89, 0x73, 0xaa, 0xe0, 0x92, 0x28,
+	0xa9, 0xb6, 0x8a, 0x7f, 0x73, 0xaa, 0xd4, 0x12, 0xad, 0x62, 0xa8, 0xb0, 0x8b, 0x18, 0x7b, 0xa2,
+	0xd7, 0x54, 0xc4, 0x2b, 0xb0, 0x03, 0x8f, 0x1a, 0x4e, 0x3c, 0x8c, 0x08, 0x2b, 0x32, 0x12, 0x3e,
+	0xc9, 0x25, 0xed, 0x16, 0x14, 0xd2, 0x76, 0xab, 0xbf, 0x79, 0xdb, 0x73, 0x83, 0xe2, 0xb1, 0x2f,
+	0xc3, 0x30, 0x30, 0x1a, 0x18, 0x06, 0x80, 0x4d, 0xc3, 0xea, 0x9b, 0x7a, 0xb7, 0x92, 0xa9, 0xfe,
+	0x2d, 0x0d, 0xf9, 0xe4, 0xd5, 0xb5, 0xe6, 0x75, 0xea, 0x7a, 0xaf, 0xd3, 0xeb, 0x5e, 0xaf, 0xf5,
+	0x02, 0x99, 0x1f, 0xf6, 0x02, 0xd4, 0x94, 0xf2, 0xe5, 0xb7, 0x88, 0x31, 0x7d, 0xa7, 0xb8, 0xf0,
+	0x49, 0x7d, 0x57, 0xe5, 0x43, 0x60, 0xb2, 0xbe, 0x1f, 0x82, 0xac, 0xf6, 0xf8, 0xb8, 0xbd, 0x88,
+	0x05, 0xef, 0x96, 0xeb, 0x8f, 0x6e, 0x79, 0x34, 0xd6, 0xba, 0xa8, 0xdb, 0xa5, 0xab, 0x57, 0x10,
+	0xb6, 0x34, 0xad, 0xee, 0x41, 0x3e, 0x81, 0x57, 0x5f, 0x53, 0x77, 0x54, 0x96, 0xa4, 0x92, 0x2a,
+	0x9f, 0x4e, 0xaa, 0x7c, 0xa6, 0x71, 0xf0, 0x6d, 0x6b, 0xe2, 0x27, 0x7b, 0x79, 0xe2, 0xb7, 0xb5,
+	0xc7, 0xce, 0x04, 0x5f, 0x02, 0xbb, 0xe7, 0xfb, 0xbb, 0xa2, 0x6d, 0xd8, 0x7d, 0xeb, 0x6f, 0x76,
+	0x5f, 0xe0, 0x74, 0xe5, 0xa2, 0x9e, 0x6c, 0x0a, 0xdd, 0xfd, 0xff, 0x03, 0xf3, 0x9f, 0x3a, 0x1d,
+	0xe2, 0x13, 0x00, 0x00,
 }
@@ -41,6 +41,10 @@ var (
 	}, models.WithNameTemplate("{{.InterfaceFrom}}/to/{{.InterfaceTo}}"))
 )
 
+// PhysAddressFromPool is a special value for Interface.PhysAddress, which requests
+// the MAC address to be allocated from the MAC pool configured for VPP ifplugin.
+const PhysAddressFromPool = "pool"
+
 // InterfaceKey returns the key used in NB DB to store the configuration of the
 // given vpp interface.
 func InterfaceKey(name string) string {
@@ -635,6 +639,16 @@ func ParseRxModesKey(key string) (ifaceName string, isRxModesKey bool) {
 	return
 }
 
+// GetVrfForIpv6 returns ID of the VRF table that the interface is assigned to
+// for IPv6. Unless overridden by <vrf_ipv6>, the interface is assigned to the same
+// VRF table for both IP versions.
+func (m *Interface) GetVrfForIpv6() uint32 {
+	if vrf := m.GetVrfIpv6(); vrf != 0 {
+		return vrf
+	}
+	return m.GetVrf()
+}
+
 // MarshalJSON ensures that field of type 'oneOf' is correctly marshaled
 // by using protobuf json marshaller
 func (m *Interface) MarshalJSON() ([]byte, error) {
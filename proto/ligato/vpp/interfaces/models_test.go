@@ -1306,3 +1306,44 @@ func TestParseInterfaceWithIPKey(t *testing.T) {
 		})
 	}
 }
+
+func TestInterfaceGetVrfForIpv6(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       *Interface
+		expectedVrf uint32
+	}{
+		{
+			name:        "nil interface",
+			expectedVrf: 0,
+		},
+		{
+			name:        "default VRF",
+			iface:       &Interface{Name: "memif0"},
+			expectedVrf: 0,
+		},
+		{
+			name:        "same VRF for both IP versions",
+			iface:       &Interface{Name: "memif0", Vrf: 10},
+			expectedVrf: 10,
+		},
+		{
+			name:        "different IPv4 and IPv6 VRFs",
+			iface:       &Interface{Name: "memif0", Vrf: 10, VrfIpv6: 20},
+			expectedVrf: 20,
+		},
+		{
+			name:        "IPv6 VRF only",
+			iface:       &Interface{Name: "memif0", VrfIpv6: 20},
+			expectedVrf: 20,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vrf := test.iface.GetVrfForIpv6()
+			if vrf != test.expectedVrf {
+				t.Errorf("expected IPv6 VRF: %d\tgot: %d", test.expectedVrf, vrf)
+			}
+		})
+	}
+}
@@ -365,6 +365,122 @@ func (m *DHCPProxy_DHCPServer) GetIpAddress() string {
 	return ""
 }
 
+// IPReassembly defines global settings of the IP (full) reassembly.
+// Unset (zero) values mean VPP defaults.
+type IPReassembly struct {
+	Ipv4                 *IPReassembly_Settings `protobuf:"bytes,1,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
+	Ipv6                 *IPReassembly_Settings `protobuf:"bytes,2,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *IPReassembly) Reset()         { *m = IPReassembly{} }
+func (m *IPReassembly) String() string { return proto.CompactTextString(m) }
+func (*IPReassembly) ProtoMessage()    {}
+func (*IPReassembly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb46f906a6f7c0e7, []int{3}
+}
+
+func (m *IPReassembly) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IPReassembly.Unmarshal(m, b)
+}
+func (m *IPReassembly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IPReassembly.Marshal(b, m, deterministic)
+}
+func (m *IPReassembly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IPReassembly.Merge(m, src)
+}
+func (m *IPReassembly) XXX_Size() int {
+	return xxx_messageInfo_IPReassembly.Size(m)
+}
+func (m *IPReassembly) XXX_DiscardUnknown() {
+	xxx_messageInfo_IPReassembly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IPReassembly proto.InternalMessageInfo
+
+func (m *IPReassembly) GetIpv4() *IPReassembly_Settings {
+	if m != nil {
+		return m.Ipv4
+	}
+	return nil
+}
+
+func (m *IPReassembly) GetIpv6() *IPReassembly_Settings {
+	if m != nil {
+		return m.Ipv6
+	}
+	return nil
+}
+
+type IPReassembly_Settings struct {
+	// Reassembly timeout (in milliseconds).
+	TimeoutMs uint32 `protobuf:"varint,1,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// Maximum number of concurrent reassemblies.
+	MaxReassemblies uint32 `protobuf:"varint,2,opt,name=max_reassemblies,json=maxReassemblies,proto3" json:"max_reassemblies,omitempty"`
+	// Maximum number of fragments per reassembly (not supported by VPP 19.04).
+	MaxReassemblyLength uint32 `protobuf:"varint,3,opt,name=max_reassembly_length,json=maxReassemblyLength,proto3" json:"max_reassembly_length,omitempty"`
+	// Interval (in milliseconds) of the walk expiring reassemblies.
+	ExpireWalkIntervalMs uint32   `protobuf:"varint,4,opt,name=expire_walk_interval_ms,json=expireWalkIntervalMs,proto3" json:"expire_walk_interval_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IPReassembly_Settings) Reset()         { *m = IPReassembly_Settings{} }
+func (m *IPReassembly_Settings) String() string { return proto.CompactTextString(m) }
+func (*IPReassembly_Settings) ProtoMessage()    {}
+func (*IPReassembly_Settings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb46f906a6f7c0e7, []int{3, 0}
+}
+
+func (m *IPReassembly_Settings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IPReassembly_Settings.Unmarshal(m, b)
+}
+func (m *IPReassembly_Settings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IPReassembly_Settings.Marshal(b, m, deterministic)
+}
+func (m *IPReassembly_Settings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IPReassembly_Settings.Merge(m, src)
+}
+func (m *IPReassembly_Settings) XXX_Size() int {
+	return xxx_messageInfo_IPReassembly_Settings.Size(m)
+}
+func (m *IPReassembly_Settings) XXX_DiscardUnknown() {
+	xxx_messageInfo_IPReassembly_Settings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IPReassembly_Settings proto.InternalMessageInfo
+
+func (m *IPReassembly_Settings) GetTimeoutMs() uint32 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+func (m *IPReassembly_Settings) GetMaxReassemblies() uint32 {
+	if m != nil {
+		return m.MaxReassemblies
+	}
+	return 0
+}
+
+func (m *IPReassembly_Settings) GetMaxReassemblyLength() uint32 {
+	if m != nil {
+		return m.MaxReassemblyLength
+	}
+	return 0
+}
+
+func (m *IPReassembly_Settings) GetExpireWalkIntervalMs() uint32 {
+	if m != nil {
+		return m.ExpireWalkIntervalMs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ligato.vpp.l3.IPScanNeighbor_Mode", IPScanNeighbor_Mode_name, IPScanNeighbor_Mode_value)
 	proto.RegisterType((*ProxyARP)(nil), "ligato.vpp.l3.ProxyARP")
@@ -373,43 +489,53 @@ func init() {
 	proto.RegisterType((*IPScanNeighbor)(nil), "ligato.vpp.l3.IPScanNeighbor")
 	proto.RegisterType((*DHCPProxy)(nil), "ligato.vpp.l3.DHCPProxy")
 	proto.RegisterType((*DHCPProxy_DHCPServer)(nil), "ligato.vpp.l3.DHCPProxy.DHCPServer")
+	proto.RegisterType((*IPReassembly)(nil), "ligato.vpp.l3.IPReassembly")
+	proto.RegisterType((*IPReassembly_Settings)(nil), "ligato.vpp.l3.IPReassembly.Settings")
 }
 
 func init() { proto.RegisterFile("ligato/vpp/l3/l3.proto", fileDescriptor_eb46f906a6f7c0e7) }
 
 var fileDescriptor_eb46f906a6f7c0e7 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xdf, 0x8a, 0x9b, 0x40,
-	0x14, 0xc6, 0x6b, 0x62, 0xb2, 0xf1, 0xe4, 0xcf, 0xa6, 0x03, 0x2d, 0x12, 0x08, 0x4d, 0xdd, 0x42,
-	0x97, 0x42, 0x15, 0xe2, 0x36, 0x37, 0xa5, 0x17, 0x49, 0x53, 0x58, 0xa1, 0xdb, 0x8a, 0x49, 0xf7,
-	0xa2, 0x37, 0x32, 0xab, 0x93, 0x44, 0x50, 0x67, 0x98, 0x71, 0xc5, 0x7d, 0xae, 0xbe, 0x4b, 0x9f,
-	0xa4, 0x0f, 0x50, 0x1c, 0x75, 0x9b, 0x2c, 0xf4, 0xee, 0xf0, 0xcd, 0xef, 0xf3, 0x3b, 0x9e, 0x99,
-	0x03, 0x2f, 0xe3, 0x68, 0x8f, 0x33, 0x6a, 0xe5, 0x8c, 0x59, 0xb1, 0x6d, 0xc5, 0xb6, 0xc9, 0x38,
-	0xcd, 0x28, 0x1a, 0x56, 0xba, 0x99, 0x33, 0x66, 0xc6, 0xb6, 0xf1, 0x47, 0x81, 0x9e, 0xcb, 0x69,
-	0xf1, 0xb0, 0xf4, 0x5c, 0xb4, 0x04, 0x88, 0xd2, 0x8c, 0xf0, 0x1d, 0x0e, 0x88, 0xd0, 0x95, 0x59,
-	0xfb, 0xb2, 0x3f, 0x7f, 0x6d, 0x9e, 0x18, 0xcc, 0x06, 0x36, 0x9d, 0x86, 0xf4, 0x8e, 0x4c, 0xe8,
-	0x03, 0x74, 0x39, 0x4e, 0xf7, 0x44, 0xe8, 0x2d, 0x69, 0x9f, 0xfe, 0xcf, 0xee, 0x95, 0x94, 0x57,
-	0xc3, 0x93, 0x57, 0xa0, 0x3d, 0x7e, 0x0f, 0x21, 0x50, 0x53, 0x9c, 0x10, 0x5d, 0x99, 0x29, 0x97,
-	0x9a, 0x27, 0xeb, 0xc9, 0x0d, 0x74, 0xa4, 0x03, 0x19, 0x30, 0xdc, 0x45, 0x5c, 0x64, 0x7e, 0xc4,
-	0x7c, 0x1c, 0x86, 0xbc, 0xa6, 0xfa, 0x52, 0x74, 0xd8, 0x32, 0x0c, 0x39, 0x9a, 0xc1, 0x20, 0xc6,
-	0x47, 0x48, 0x4b, 0x22, 0x50, 0x6a, 0x15, 0x61, 0xfc, 0x6a, 0xc1, 0xc8, 0x71, 0x37, 0x01, 0x4e,
-	0xbf, 0x91, 0x68, 0x7f, 0xb8, 0xa3, 0x1c, 0x2d, 0x40, 0x4d, 0x68, 0x58, 0xa5, 0x8e, 0xe6, 0xc6,
-	0x93, 0xbe, 0x4f, 0x61, 0xf3, 0x86, 0x86, 0xc4, 0x93, 0x3c, 0xba, 0x80, 0xa1, 0x08, 0x70, 0xea,
-	0xcb, 0x21, 0xe4, 0x38, 0x96, 0x69, 0x43, 0x6f, 0x50, 0x8a, 0x4e, 0xad, 0x95, 0x5d, 0x27, 0xb8,
-	0xf0, 0x19, 0xa7, 0x81, 0x9f, 0x45, 0x09, 0xd1, 0xdb, 0x12, 0xea, 0x27, 0xb8, 0x70, 0x39, 0x0d,
-	0xb6, 0x51, 0x42, 0xd0, 0x14, 0xa0, 0x64, 0xee, 0x59, 0x88, 0x33, 0xa2, 0xab, 0x12, 0xd0, 0x12,
-	0x5c, 0xfc, 0x90, 0x02, 0x7a, 0x03, 0xa3, 0x26, 0xc7, 0x0f, 0x49, 0x8c, 0x1f, 0xf4, 0xce, 0x49,
-	0xd0, 0xba, 0xd4, 0xd0, 0x5b, 0x38, 0x17, 0x19, 0x8e, 0x89, 0x9f, 0x1d, 0x38, 0x11, 0x07, 0x1a,
-	0x87, 0x7a, 0x57, 0x62, 0x23, 0x29, 0x6f, 0x1b, 0xd5, 0x98, 0x83, 0x5a, 0xfe, 0x04, 0x1a, 0x40,
-	0x6f, 0xed, 0x6c, 0x96, 0xab, 0xaf, 0x5f, 0xd6, 0xe3, 0x67, 0xa8, 0x07, 0xaa, 0xe3, 0xde, 0x5e,
-	0x8d, 0x95, 0xba, 0x5a, 0x8c, 0x5b, 0x65, 0xb5, 0xfa, 0xbe, 0xbd, 0x1e, 0xb7, 0x8d, 0xdf, 0x0a,
-	0x68, 0xeb, 0xeb, 0xcf, 0xae, 0xbc, 0x44, 0xf4, 0x0e, 0x9e, 0x0b, 0x7a, 0xcf, 0x03, 0xd2, 0xcc,
-	0x99, 0x08, 0x51, 0xdf, 0xc6, 0x79, 0x75, 0x50, 0x0d, 0x9b, 0x08, 0x81, 0x26, 0xa0, 0xf1, 0xc2,
-	0xcf, 0xf9, 0xce, 0x8f, 0xc2, 0x7a, 0x40, 0x67, 0xbc, 0xb8, 0xe5, 0x3b, 0x27, 0x44, 0x9f, 0xe0,
-	0x4c, 0x10, 0x9e, 0x13, 0x2e, 0x74, 0x55, 0xbe, 0x99, 0x8b, 0x27, 0xb3, 0x7f, 0x8c, 0x94, 0xd5,
-	0x46, 0xb2, 0x5e, 0xe3, 0x99, 0xac, 0x00, 0xfe, 0xc9, 0xe8, 0x05, 0x74, 0xeb, 0x14, 0x45, 0xa6,
-	0x74, 0x72, 0x99, 0x31, 0x05, 0x38, 0x6a, 0xb2, 0x7a, 0x0f, 0x5a, 0xd4, 0xb4, 0xb7, 0x5a, 0xfc,
-	0xbc, 0xda, 0xd3, 0x26, 0x35, 0x92, 0x4b, 0xf3, 0x1e, 0xef, 0x49, 0x9a, 0x59, 0xb9, 0x6d, 0xc9,
-	0xbd, 0xb1, 0x4e, 0xd6, 0xe9, 0x63, 0xce, 0x98, 0x1f, 0xdb, 0x77, 0x5d, 0x79, 0x66, 0xff, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0xad, 0x6b, 0xa6, 0xb1, 0x6d, 0x03, 0x00, 0x00,
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x94, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x69, 0xd7, 0x75, 0xcb, 0xe9, 0x9f, 0x15, 0xc3, 0xa0, 0xaa, 0x34, 0x31, 0xb2, 0x49,
+	0x0c, 0x24, 0x52, 0xa9, 0x2d, 0x15, 0x12, 0xe2, 0xa2, 0xa5, 0x48, 0x8b, 0xb4, 0x42, 0x95, 0x8e,
+	0x21, 0x71, 0x63, 0x79, 0x8d, 0x9b, 0x5a, 0xa4, 0x49, 0x64, 0xa7, 0xa5, 0x7d, 0x2e, 0x1e, 0x80,
+	0x1b, 0x9e, 0x81, 0x27, 0xe1, 0x01, 0xb0, 0x9d, 0x64, 0x6b, 0x87, 0xb8, 0x40, 0xaa, 0x54, 0xeb,
+	0x77, 0xbe, 0xcf, 0xe7, 0xf8, 0xf8, 0xc4, 0xf0, 0xc8, 0x67, 0x1e, 0x89, 0xc3, 0xe6, 0x32, 0x8a,
+	0x9a, 0x7e, 0x5b, 0xfe, 0xac, 0x88, 0x87, 0x71, 0x88, 0x2a, 0x09, 0xb7, 0x24, 0xb7, 0xfc, 0xb6,
+	0xf9, 0x3b, 0x07, 0xfb, 0x23, 0x1e, 0xae, 0xd6, 0x3d, 0x67, 0x84, 0x7a, 0x00, 0x2c, 0x88, 0x29,
+	0x9f, 0x92, 0x09, 0x15, 0xf5, 0xdc, 0xf1, 0xce, 0x59, 0xa9, 0xf5, 0xd4, 0xda, 0x32, 0x58, 0x99,
+	0xd8, 0xb2, 0x33, 0xa5, 0xb3, 0x61, 0x42, 0xaf, 0xa0, 0xc8, 0x49, 0xe0, 0x49, 0x7b, 0x5e, 0xdb,
+	0x8f, 0xfe, 0x65, 0x77, 0x94, 0xca, 0x49, 0xc5, 0x8d, 0x27, 0x60, 0xdc, 0xec, 0x87, 0x10, 0x14,
+	0x02, 0x32, 0xa7, 0xb2, 0x80, 0xdc, 0x99, 0xe1, 0xe8, 0x75, 0x63, 0x08, 0xbb, 0xda, 0x81, 0x4c,
+	0xa8, 0x4c, 0x19, 0x17, 0x31, 0x66, 0x11, 0x26, 0xae, 0xcb, 0x53, 0x55, 0x49, 0x43, 0x3b, 0xea,
+	0x49, 0x84, 0x8e, 0xa1, 0xec, 0x93, 0x0d, 0x49, 0x5e, 0x4b, 0x40, 0xb1, 0x44, 0x61, 0x7e, 0xcf,
+	0x43, 0xd5, 0x1e, 0x8d, 0x27, 0x24, 0xf8, 0x40, 0x99, 0x37, 0xbb, 0x0e, 0x39, 0xea, 0x42, 0x61,
+	0x1e, 0xba, 0x49, 0xd6, 0x6a, 0xcb, 0xbc, 0x53, 0xf7, 0xb6, 0xd8, 0x1a, 0x4a, 0xa5, 0xa3, 0xf5,
+	0xe8, 0x04, 0x2a, 0x42, 0x86, 0xb0, 0x6e, 0xc2, 0x92, 0xf8, 0x3a, 0x5b, 0xc5, 0x29, 0x2b, 0x68,
+	0xa7, 0x4c, 0x55, 0x3d, 0x27, 0x2b, 0x2c, 0xaf, 0x60, 0x82, 0x63, 0x26, 0xcf, 0xb6, 0xa3, 0x45,
+	0x25, 0x09, 0x65, 0x47, 0x26, 0x97, 0x12, 0xa1, 0x23, 0x00, 0xa5, 0x59, 0x44, 0x2e, 0x89, 0x69,
+	0xbd, 0xa0, 0x05, 0x86, 0x24, 0x9f, 0x34, 0x40, 0xa7, 0x50, 0xcd, 0xf2, 0x60, 0x97, 0xfa, 0x64,
+	0x5d, 0xdf, 0xdd, 0x4a, 0x34, 0x50, 0x0c, 0x3d, 0x83, 0x03, 0x11, 0x13, 0x9f, 0xe2, 0x78, 0xc6,
+	0xa9, 0x98, 0x85, 0xbe, 0x5b, 0x2f, 0x6a, 0x59, 0x55, 0xe3, 0xcb, 0x8c, 0x9a, 0x2d, 0x28, 0xa8,
+	0x43, 0xa0, 0x32, 0xec, 0x0f, 0xec, 0x71, 0xaf, 0x7f, 0xf1, 0x7e, 0x50, 0xbb, 0x87, 0xf6, 0xa1,
+	0x60, 0x8f, 0xae, 0x3a, 0xb5, 0x5c, 0xba, 0xea, 0xd6, 0xf2, 0x6a, 0xd5, 0xff, 0x78, 0x79, 0x5e,
+	0xdb, 0x31, 0x7f, 0xe5, 0xc0, 0x18, 0x9c, 0xbf, 0x1b, 0xe9, 0x4b, 0x44, 0x2f, 0xe0, 0xbe, 0x08,
+	0x17, 0x7c, 0x42, 0xb3, 0x3e, 0x53, 0x21, 0xd2, 0xdb, 0x38, 0x48, 0x02, 0x49, 0xb3, 0x25, 0x46,
+	0x0d, 0x30, 0xf8, 0x0a, 0x2f, 0xf9, 0x14, 0x33, 0x37, 0x6d, 0xd0, 0x1e, 0x5f, 0x5d, 0xf1, 0xa9,
+	0xed, 0xa2, 0xb7, 0xb0, 0x27, 0x64, 0x97, 0x28, 0x17, 0xf2, 0xd0, 0x6a, 0x66, 0x4e, 0xee, 0xf4,
+	0xfe, 0x26, 0xa5, 0x5e, 0x8d, 0xb5, 0xd6, 0xc9, 0x3c, 0x8d, 0x3e, 0xc0, 0x2d, 0x46, 0x87, 0x50,
+	0x4c, 0xb3, 0xe4, 0x74, 0x96, 0xdd, 0xa5, 0xce, 0x21, 0x7b, 0xbb, 0x51, 0x64, 0x32, 0x0f, 0x06,
+	0xcb, 0xca, 0x33, 0x7f, 0xe6, 0xa1, 0x6c, 0x8f, 0x1c, 0x4a, 0x84, 0xa0, 0xf3, 0x6b, 0x7f, 0x8d,
+	0x5e, 0x43, 0x81, 0x45, 0xcb, 0x8e, 0xde, 0xa4, 0xd4, 0x3a, 0xfd, 0x6b, 0x18, 0x6e, 0xa5, 0xd6,
+	0x98, 0xc6, 0x31, 0x0b, 0x3c, 0xe1, 0x68, 0x47, 0xea, 0xec, 0xea, 0x1c, 0xff, 0xe3, 0xec, 0x36,
+	0x7e, 0xc8, 0x4f, 0x31, 0x43, 0xaa, 0x60, 0x35, 0x27, 0xe1, 0x22, 0xc6, 0x73, 0x91, 0x9e, 0xc5,
+	0x48, 0xc9, 0x50, 0xa0, 0xe7, 0x50, 0x53, 0xb3, 0xc2, 0xb3, 0xcd, 0x18, 0x15, 0x69, 0x5b, 0x0f,
+	0x24, 0x77, 0x36, 0x30, 0x6a, 0xc1, 0xe1, 0x96, 0x74, 0x8d, 0x7d, 0x1a, 0x78, 0xf1, 0x2c, 0x1d,
+	0xc1, 0x07, 0x9b, 0xfa, 0xf5, 0x85, 0x0e, 0xc9, 0xaf, 0xf8, 0x31, 0x5d, 0x45, 0x8c, 0x53, 0xfc,
+	0x8d, 0xf8, 0x5f, 0x6f, 0x46, 0x5b, 0x95, 0x92, 0xcc, 0xe5, 0xc3, 0x24, 0xfc, 0x59, 0x46, 0xb3,
+	0x19, 0x1f, 0x8a, 0x7e, 0xf7, 0x4b, 0xc7, 0x0b, 0xb3, 0x13, 0x33, 0xfd, 0xf6, 0xbc, 0x24, 0x1e,
+	0x0d, 0xe2, 0xe6, 0xb2, 0xdd, 0xd4, 0xcf, 0x4f, 0x73, 0xeb, 0x55, 0x7a, 0x23, 0xff, 0xb0, 0xdf,
+	0xbe, 0x2e, 0xea, 0x58, 0xfb, 0x0f, 0x6a, 0x75, 0xaa, 0xa3, 0xb4, 0x04, 0x00, 0x00,
 }
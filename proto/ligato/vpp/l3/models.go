@@ -57,6 +57,12 @@ var (
 		Version: "v2",
 	})
 
+	ModelIPReassembly = models.Register(&IPReassembly{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "ipreassembly-global",
+		Version: "v2",
+	})
+
 	ModelVrfTable = models.Register(&VrfTable{}, models.Spec{
 		Module:  ModuleName,
 		Type:    "vrf-table",
@@ -92,6 +98,11 @@ func IPScanNeighborKey() string {
 	return models.Key(&IPScanNeighbor{})
 }
 
+// IPReassemblyKey returns key for global IP reassembly settings
+func IPReassemblyKey() string {
+	return models.Key(&IPReassembly{})
+}
+
 // RouteKey returns the key used in ETCD to store vpp route for vpp instance.
 func RouteKey(iface string, vrf uint32, dstNet string, nextHopAddr string) string {
 	return models.Key(&Route{
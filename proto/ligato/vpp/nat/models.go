@@ -51,6 +51,18 @@ var (
 			"/address/{{.FirstIp}}"+
 			"{{if and .LastIp (ne .FirstIp .LastIp)}}-{{.LastIp}}{{end}}",
 	))
+
+	ModelNat66Interface = models.Register(&Nat66Interface{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat66-interface",
+		Version: "v2",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelNat66StaticMapping = models.Register(&Nat66StaticMapping{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat66-static-mapping",
+		Version: "v2",
+	}, models.WithNameTemplate("vrf/{{.VrfId}}/local/{{.LocalIp}}"))
 )
 
 // GlobalNAT44Key returns key for Nat44Global.
@@ -84,6 +96,23 @@ func Nat44AddressPoolKey(vrf uint32, firstIP, lastIP string) string {
 	})
 }
 
+// Nat66InterfaceKey returns the key used in NB DB to store the configuration of the
+// given NAT66 interface.
+func Nat66InterfaceKey(name string) string {
+	return models.Key(&Nat66Interface{
+		Name: name,
+	})
+}
+
+// Nat66StaticMappingKey returns the key used in NB DB to store the configuration of the
+// NAT66 static mapping for the given local IPv6 address.
+func Nat66StaticMappingKey(vrf uint32, localIP string) string {
+	return models.Key(&Nat66StaticMapping{
+		VrfId:   vrf,
+		LocalIp: localIP,
+	})
+}
+
 /* NAT44 interface (derived) */
 
 const (
@@ -116,6 +145,14 @@ const (
 	twiceNatOff = "off"
 )
 
+/* NAT44 twice-NAT pool (derived) */
+
+const (
+	// TwiceNAT44PoolKeyPrefix is a common prefix for (derived) keys each representing
+	// NAT44 address pool (or a single address) enabled for twice-NAT.
+	TwiceNAT44PoolKeyPrefix = "vpp/nat44/twice-nat-pool/"
+)
+
 const (
 	// InvalidKeyPart is used in key for parts which are invalid
 	InvalidKeyPart = "<invalid>"
@@ -188,3 +225,12 @@ func ParseDerivedAddressNAT44Key(key string) (address string, twiceNat bool, isA
 	}
 	return
 }
+
+/* NAT44 twice-NAT pool (derived) */
+
+// DerivedTwiceNAT44PoolKey returns (derived) key representing NAT44 address pool
+// enabled for twice-NAT.
+func DerivedTwiceNAT44PoolKey(vrf uint32, firstIP, lastIP string) string {
+	return TwiceNAT44PoolKeyPrefix +
+		ModelNat44AddressPool.StripKeyPrefix(Nat44AddressPoolKey(vrf, firstIP, lastIP))
+}
@@ -283,3 +283,37 @@ func TestParseAddressNAT44Key(t *testing.T) {
 		})
 	}
 }
+
+func TestTwiceNAT44PoolKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		vrf         uint32
+		firstIP     string
+		lastIP      string
+		expectedKey string
+	}{
+		{
+			name:        "single address",
+			vrf:         0,
+			firstIP:     "192.168.1.1",
+			expectedKey: "vpp/nat44/twice-nat-pool/vrf/0/address/192.168.1.1",
+		},
+		{
+			name:        "address range",
+			vrf:         5,
+			firstIP:     "192.168.1.1",
+			lastIP:      "192.168.1.10",
+			expectedKey: "vpp/nat44/twice-nat-pool/vrf/5/address/192.168.1.1-192.168.1.10",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := DerivedTwiceNAT44PoolKey(test.vrf, test.firstIP, test.lastIP)
+			if key != test.expectedKey {
+				t.Errorf("failed for: vrf=%d firstIP=%s lastIP=%s\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.vrf, test.firstIP, test.lastIP, test.expectedKey, key)
+			}
+		})
+	}
+}
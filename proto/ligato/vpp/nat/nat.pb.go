@@ -772,6 +772,126 @@ func (m *VirtualReassembly) GetDropFragments() bool {
 	return false
 }
 
+// Nat66Interface defines a local network interface enabled for stateless NAT66.
+type Nat66Interface struct {
+	// Interface name (logical).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Enable/disable NAT66 on inside (cannot be combined with nat_outside).
+	NatInside bool `protobuf:"varint,2,opt,name=nat_inside,json=natInside,proto3" json:"nat_inside,omitempty"`
+	// Enable/disable NAT66 on outside.
+	NatOutside           bool     `protobuf:"varint,3,opt,name=nat_outside,json=natOutside,proto3" json:"nat_outside,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Nat66Interface) Reset()         { *m = Nat66Interface{} }
+func (m *Nat66Interface) String() string { return proto.CompactTextString(m) }
+func (*Nat66Interface) ProtoMessage()    {}
+func (*Nat66Interface) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c5496f531b4b7d3, []int{5}
+}
+
+func (m *Nat66Interface) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Nat66Interface.Unmarshal(m, b)
+}
+func (m *Nat66Interface) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Nat66Interface.Marshal(b, m, deterministic)
+}
+func (m *Nat66Interface) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Nat66Interface.Merge(m, src)
+}
+func (m *Nat66Interface) XXX_Size() int {
+	return xxx_messageInfo_Nat66Interface.Size(m)
+}
+func (m *Nat66Interface) XXX_DiscardUnknown() {
+	xxx_messageInfo_Nat66Interface.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Nat66Interface proto.InternalMessageInfo
+
+func (m *Nat66Interface) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Nat66Interface) GetNatInside() bool {
+	if m != nil {
+		return m.NatInside
+	}
+	return false
+}
+
+func (m *Nat66Interface) GetNatOutside() bool {
+	if m != nil {
+		return m.NatOutside
+	}
+	return false
+}
+
+// Nat66StaticMapping defines a 1:1 mapping between local and external IPv6 address
+// for stateless NAT66.
+type Nat66StaticMapping struct {
+	// Local (inside) IPv6 address in the canonical form (RFC 5952), e.g. 2001:db8::1.
+	LocalIp string `protobuf:"bytes,1,opt,name=local_ip,json=localIp,proto3" json:"local_ip,omitempty"`
+	// External (outside) IPv6 address in the canonical form (RFC 5952).
+	ExternalIp string `protobuf:"bytes,2,opt,name=external_ip,json=externalIp,proto3" json:"external_ip,omitempty"`
+	// VRF (table ID) of the local address.
+	// Non-zero VRF has to be explicitly created (see api/models/vpp/l3/vrf.proto).
+	VrfId                uint32   `protobuf:"varint,3,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Nat66StaticMapping) Reset()         { *m = Nat66StaticMapping{} }
+func (m *Nat66StaticMapping) String() string { return proto.CompactTextString(m) }
+func (*Nat66StaticMapping) ProtoMessage()    {}
+func (*Nat66StaticMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c5496f531b4b7d3, []int{6}
+}
+
+func (m *Nat66StaticMapping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Nat66StaticMapping.Unmarshal(m, b)
+}
+func (m *Nat66StaticMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Nat66StaticMapping.Marshal(b, m, deterministic)
+}
+func (m *Nat66StaticMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Nat66StaticMapping.Merge(m, src)
+}
+func (m *Nat66StaticMapping) XXX_Size() int {
+	return xxx_messageInfo_Nat66StaticMapping.Size(m)
+}
+func (m *Nat66StaticMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_Nat66StaticMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Nat66StaticMapping proto.InternalMessageInfo
+
+func (m *Nat66StaticMapping) GetLocalIp() string {
+	if m != nil {
+		return m.LocalIp
+	}
+	return ""
+}
+
+func (m *Nat66StaticMapping) GetExternalIp() string {
+	if m != nil {
+		return m.ExternalIp
+	}
+	return ""
+}
+
+func (m *Nat66StaticMapping) GetVrfId() uint32 {
+	if m != nil {
+		return m.VrfId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ligato.vpp.nat.DNat44_Protocol", DNat44_Protocol_name, DNat44_Protocol_value)
 	proto.RegisterEnum("ligato.vpp.nat.DNat44_StaticMapping_TwiceNatMode", DNat44_StaticMapping_TwiceNatMode_name, DNat44_StaticMapping_TwiceNatMode_value)
@@ -785,66 +905,69 @@ func init() {
 	proto.RegisterType((*Nat44Interface)(nil), "ligato.vpp.nat.Nat44Interface")
 	proto.RegisterType((*Nat44AddressPool)(nil), "ligato.vpp.nat.Nat44AddressPool")
 	proto.RegisterType((*VirtualReassembly)(nil), "ligato.vpp.nat.VirtualReassembly")
+	proto.RegisterType((*Nat66Interface)(nil), "ligato.vpp.nat.Nat66Interface")
+	proto.RegisterType((*Nat66StaticMapping)(nil), "ligato.vpp.nat.Nat66StaticMapping")
 }
 
 func init() { proto.RegisterFile("ligato/vpp/nat/nat.proto", fileDescriptor_6c5496f531b4b7d3) }
 
 var fileDescriptor_6c5496f531b4b7d3 = []byte{
-	// 892 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xd1, 0x6e, 0xdb, 0x36,
-	0x14, 0xad, 0x6c, 0xc7, 0x92, 0xaf, 0x62, 0xd7, 0x21, 0x36, 0x4c, 0xf3, 0xd6, 0xc5, 0x73, 0xd7,
-	0x22, 0x03, 0x56, 0x1b, 0x4b, 0x8a, 0x61, 0x40, 0x9f, 0x92, 0x26, 0x29, 0x34, 0x24, 0x99, 0xa0,
-	0x74, 0x1b, 0xb0, 0x17, 0x81, 0xb6, 0x28, 0x83, 0x80, 0x2c, 0x12, 0x24, 0xed, 0x26, 0xc3, 0xfe,
-	0x60, 0xbf, 0xb1, 0x6f, 0xd8, 0xc3, 0xbe, 0x61, 0x7f, 0xb0, 0x9f, 0x19, 0x44, 0x52, 0xb6, 0xe2,
-	0x35, 0x45, 0x81, 0x3e, 0x18, 0x10, 0xcf, 0xbd, 0x3c, 0xbc, 0xf7, 0xf0, 0xfa, 0x10, 0x82, 0x9c,
-	0xce, 0xb1, 0x62, 0x93, 0x15, 0xe7, 0x93, 0x02, 0xab, 0xf2, 0x37, 0xe6, 0x82, 0x29, 0x86, 0x7a,
-	0x26, 0x32, 0x5e, 0x71, 0x3e, 0x2e, 0xb0, 0x1a, 0xfd, 0xdb, 0x04, 0xff, 0x0a, 0xab, 0xe7, 0xcf,
-	0x5f, 0xe5, 0x6c, 0x8a, 0x73, 0xf4, 0x05, 0x40, 0xc6, 0xc4, 0x1b, 0x2c, 0x52, 0x5a, 0xcc, 0x03,
-	0x67, 0xe8, 0x1c, 0x78, 0x71, 0x0d, 0x41, 0x11, 0xf4, 0x0a, 0xac, 0x12, 0x5a, 0x28, 0x22, 0x32,
-	0x3c, 0x23, 0x32, 0x68, 0x0c, 0x9b, 0x07, 0xfe, 0xe1, 0x93, 0xf1, 0x5d, 0xe2, 0x71, 0x8d, 0x74,
-	0x1c, 0x56, 0xd9, 0x27, 0x8d, 0xc0, 0x89, 0xbb, 0x05, 0x56, 0x6b, 0x44, 0xa2, 0x1f, 0x60, 0x17,
-	0xa7, 0xa9, 0x20, 0x52, 0x26, 0x9c, 0xb1, 0x3c, 0x68, 0x6a, 0xbe, 0xc7, 0xef, 0xe2, 0x3b, 0x36,
-	0xf9, 0x9a, 0xcd, 0xb7, 0x9b, 0x23, 0xc6, 0x72, 0x14, 0x01, 0x5a, 0x51, 0xa1, 0x96, 0x38, 0x4f,
-	0x04, 0xc1, 0x52, 0x92, 0xc5, 0x34, 0xbf, 0x0d, 0x5a, 0x43, 0xe7, 0xc0, 0x3f, 0xfc, 0x72, 0x9b,
-	0xf1, 0x67, 0x93, 0x19, 0xaf, 0x13, 0xe3, 0xbd, 0xd5, 0x36, 0x34, 0x98, 0x41, 0x67, 0x5d, 0x2b,
-	0x42, 0xd0, 0x2a, 0xf0, 0x82, 0x68, 0x59, 0x3a, 0xb1, 0xfe, 0x46, 0x9f, 0x41, 0x87, 0xca, 0x84,
-	0x16, 0x92, 0xa6, 0x24, 0x68, 0x68, 0xbd, 0x3c, 0x2a, 0x43, 0xbd, 0x46, 0x4f, 0xa0, 0xc7, 0x96,
-	0x8a, 0x2f, 0x55, 0x92, 0x11, 0xac, 0x96, 0x82, 0x04, 0x4d, 0x9d, 0xd1, 0x35, 0xe8, 0xb9, 0x01,
-	0x07, 0xbf, 0x80, 0x6b, 0x5b, 0x42, 0x01, 0xb8, 0xb6, 0x21, 0x7b, 0x4a, 0xb5, 0x44, 0x1f, 0x43,
-	0x7b, 0x25, 0xb2, 0x84, 0xa6, 0xfa, 0x94, 0x6e, 0xbc, 0xb3, 0x12, 0x59, 0x98, 0x96, 0xe7, 0xab,
-	0x37, 0x74, 0x46, 0x92, 0x02, 0x2b, 0xcb, 0xee, 0x69, 0xe0, 0x0a, 0xab, 0xd1, 0x3f, 0x2e, 0xb4,
-	0x4f, 0xb5, 0x72, 0xe8, 0x23, 0xd8, 0xc9, 0xf1, 0x94, 0xe4, 0x96, 0xd6, 0x2c, 0xd0, 0x19, 0xf8,
-	0x52, 0x25, 0x0b, 0xcc, 0x39, 0x2d, 0xe6, 0xd5, 0x5d, 0x7e, 0xb5, 0xad, 0x94, 0xa1, 0x18, 0x5f,
-	0x2b, 0xac, 0xe8, 0xec, 0xd2, 0x24, 0xc7, 0x20, 0x95, 0xfd, 0x94, 0xe8, 0x15, 0xf8, 0x34, 0xdd,
-	0xd0, 0x98, 0x2b, 0x7c, 0x7a, 0x0f, 0x4d, 0x98, 0x92, 0x42, 0x51, 0x75, 0xbb, 0x26, 0xa2, 0x69,
-	0x45, 0x34, 0xf8, 0xbb, 0x05, 0xdd, 0x3b, 0xc7, 0xa0, 0x67, 0x80, 0xc8, 0x8d, 0x22, 0xa2, 0xc0,
-	0xf9, 0x66, 0xea, 0x6c, 0x13, 0x7b, 0x55, 0x64, 0x73, 0x45, 0xfb, 0xe0, 0x6f, 0xd2, 0xb9, 0x96,
-	0xaa, 0x13, 0xc3, 0x3a, 0x8f, 0xa3, 0xc7, 0xd0, 0x5d, 0x27, 0x70, 0x26, 0x8c, 0x66, 0xdd, 0x78,
-	0xb7, 0x02, 0x23, 0x26, 0x14, 0x0a, 0xa1, 0x93, 0xb3, 0x99, 0xa6, 0x90, 0x41, 0x4b, 0x77, 0xf3,
-	0xcd, 0xfb, 0x88, 0x32, 0xbe, 0x28, 0x77, 0x85, 0x51, 0xec, 0xe9, 0xed, 0x21, 0x97, 0xe8, 0x05,
-	0x78, 0xfa, 0x9f, 0x37, 0x63, 0x79, 0xb0, 0x33, 0x74, 0x0e, 0x7a, 0x87, 0xfb, 0xf7, 0x30, 0x45,
-	0x36, 0x2d, 0x5e, 0x6f, 0x40, 0x57, 0xf5, 0xcb, 0x6d, 0xeb, 0xdd, 0xdf, 0xbe, 0x57, 0x1d, 0xaf,
-	0xed, 0x04, 0x5c, 0xb2, 0x94, 0x6c, 0xe6, 0x01, 0x7d, 0x0d, 0x7d, 0x49, 0xa4, 0xa4, 0xac, 0x48,
-	0x70, 0x96, 0xd1, 0x82, 0xaa, 0xdb, 0xc0, 0xd5, 0xfd, 0x3f, 0xb4, 0xf8, 0xb1, 0x85, 0x07, 0xbf,
-	0x83, 0x6b, 0x9b, 0xa9, 0x4d, 0x9e, 0x53, 0x9f, 0xbc, 0x4f, 0xc1, 0xab, 0x44, 0xb2, 0x3a, 0xbb,
-	0xb6, 0x6b, 0xf4, 0x08, 0xc0, 0x84, 0x6a, 0x0a, 0x1b, 0x45, 0xb5, 0xbc, 0x43, 0xf0, 0xb9, 0x60,
-	0x53, 0x3c, 0xa5, 0x79, 0x59, 0x41, 0x4b, 0xc7, 0xeb, 0xd0, 0xe8, 0x08, 0x76, 0xeb, 0x2d, 0xa0,
-	0x5d, 0xf0, 0x4e, 0xc3, 0xeb, 0xe3, 0x93, 0x8b, 0xb3, 0xd3, 0xfe, 0x03, 0xe4, 0x83, 0x7b, 0x76,
-	0x65, 0x16, 0x0e, 0xf2, 0xa0, 0x75, 0x7d, 0x76, 0x71, 0xde, 0x6f, 0x0c, 0xfe, 0x72, 0xe0, 0xe1,
-	0xd6, 0x70, 0xdd, 0x57, 0xfb, 0xe7, 0xd0, 0xd9, 0x0c, 0x93, 0x29, 0x7e, 0x03, 0x94, 0xe5, 0x53,
-	0x9e, 0x54, 0xff, 0xc3, 0xa6, 0x0d, 0xf3, 0xea, 0x3f, 0x8a, 0xa0, 0xa5, 0xfb, 0x32, 0x75, 0xeb,
-	0xef, 0x0f, 0xba, 0xe6, 0xd1, 0x53, 0xf0, 0x2a, 0x14, 0xb9, 0xd0, 0x7c, 0xfd, 0x32, 0xea, 0x3f,
-	0x28, 0x3f, 0x7e, 0x3a, 0x8d, 0x4c, 0x83, 0xe1, 0xcb, 0xcb, 0xa8, 0xdf, 0x18, 0xfd, 0xe1, 0x40,
-	0x4f, 0x93, 0xbc, 0xdb, 0x92, 0x1e, 0x01, 0x18, 0x8f, 0xae, 0x79, 0x52, 0x47, 0x9b, 0xae, 0x36,
-	0xa5, 0x7d, 0xf0, 0xcb, 0x30, 0x5b, 0x2a, 0x1d, 0x37, 0x9e, 0x51, 0xee, 0xf8, 0xd1, 0x20, 0x6f,
-	0x71, 0xad, 0xd6, 0x5b, 0x5c, 0x6b, 0xf4, 0x1b, 0xf4, 0x75, 0x31, 0xc7, 0x35, 0x03, 0xbe, 0x7f,
-	0x54, 0x32, 0x2a, 0xa4, 0xaa, 0x8d, 0x8a, 0x5e, 0x87, 0x1c, 0x7d, 0x02, 0x6e, 0x8e, 0x4d, 0xc4,
-	0x08, 0xdd, 0x2e, 0x97, 0x21, 0xbf, 0x6b, 0x6c, 0xad, 0x2d, 0x63, 0xfb, 0xd3, 0x81, 0xbd, 0xff,
-	0xf9, 0x77, 0x69, 0x9e, 0x8a, 0x2e, 0x08, 0x5b, 0x2a, 0x7b, 0x7c, 0xb5, 0x2c, 0x07, 0x7f, 0x81,
-	0x6f, 0x36, 0x8f, 0x02, 0xd5, 0x0f, 0x97, 0x1e, 0xfc, 0x05, 0xbe, 0x89, 0x6b, 0x70, 0x69, 0x10,
-	0x65, 0x6a, 0x26, 0xf0, 0x7c, 0x41, 0x0a, 0x25, 0x2b, 0x83, 0x58, 0xe0, 0x9b, 0xf3, 0x0a, 0x2b,
-	0x25, 0x4a, 0x05, 0xe3, 0xb5, 0x2c, 0x2b, 0x51, 0x89, 0xae, 0xd3, 0x4e, 0xbe, 0xff, 0xf5, 0xbb,
-	0x39, 0xab, 0xe6, 0x80, 0xea, 0xf7, 0xf8, 0x19, 0x9e, 0x93, 0x42, 0x4d, 0x56, 0x47, 0x13, 0x7d,
-	0xfd, 0x93, 0xbb, 0x2f, 0xf5, 0x8b, 0x15, 0xe7, 0x65, 0xc3, 0xd3, 0xb6, 0x8e, 0x1e, 0xfd, 0x17,
-	0x00, 0x00, 0xff, 0xff, 0xd6, 0xf8, 0x55, 0x38, 0xca, 0x07, 0x00, 0x00,
+	// 911 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb5, 0x56, 0x5b, 0x6e, 0xd3, 0x40,
+	0x14, 0xc5, 0x4d, 0x1a, 0x3b, 0xd7, 0x4d, 0x48, 0x47, 0x20, 0x42, 0x78, 0x95, 0x00, 0x55, 0x91,
+	0x20, 0x11, 0x2d, 0xaa, 0x90, 0xf8, 0x6a, 0x69, 0x8b, 0x8c, 0xda, 0x12, 0xb9, 0x3c, 0x24, 0x7e,
+	0xac, 0x49, 0xec, 0x44, 0x23, 0x39, 0xb1, 0x65, 0x4f, 0xc3, 0x43, 0xec, 0x80, 0x6d, 0xb0, 0x06,
+	0x3e, 0x58, 0x03, 0x3b, 0x60, 0x33, 0xdc, 0x79, 0xd8, 0x71, 0x02, 0x05, 0x24, 0xc4, 0x47, 0x14,
+	0xcf, 0xb9, 0x77, 0xee, 0xdc, 0xc7, 0x99, 0x63, 0x43, 0x33, 0x64, 0x23, 0xca, 0xa3, 0xee, 0x34,
+	0x8e, 0xbb, 0x13, 0xca, 0xc5, 0xaf, 0x13, 0x27, 0x11, 0x8f, 0x48, 0x5d, 0x59, 0x3a, 0x68, 0xe9,
+	0x20, 0xda, 0xfe, 0x5e, 0x02, 0xfb, 0x98, 0xf2, 0x87, 0x0f, 0x9f, 0x86, 0x51, 0x9f, 0x86, 0xe4,
+	0x3a, 0xc0, 0x30, 0x4a, 0xde, 0xd2, 0xc4, 0x67, 0x93, 0x51, 0xd3, 0x58, 0x33, 0x36, 0x2c, 0xb7,
+	0x80, 0x90, 0x1e, 0xd4, 0x71, 0x9b, 0xc7, 0x26, 0x3c, 0x48, 0x86, 0x74, 0x10, 0xa4, 0xcd, 0xa5,
+	0xb5, 0xd2, 0x86, 0xbd, 0x79, 0xa7, 0x33, 0x1f, 0xb8, 0x53, 0x08, 0xda, 0x71, 0x32, 0xef, 0xdd,
+	0xa5, 0xa6, 0xe1, 0xd6, 0xd0, 0x9c, 0x23, 0x29, 0x79, 0x06, 0x2b, 0xd4, 0xf7, 0x93, 0x20, 0x4d,
+	0xbd, 0x38, 0x8a, 0xc2, 0x66, 0x49, 0xc6, 0xbb, 0xf5, 0xbb, 0x78, 0x3b, 0xca, 0x5f, 0x46, 0xb3,
+	0xf5, 0xe6, 0x1e, 0xee, 0xc5, 0xec, 0xc8, 0x94, 0x25, 0xfc, 0x94, 0x86, 0x5e, 0x12, 0xd0, 0x34,
+	0x0d, 0xc6, 0xfd, 0xf0, 0x7d, 0xb3, 0x8c, 0x55, 0xd8, 0x9b, 0x37, 0x17, 0x23, 0xbe, 0x52, 0x9e,
+	0x6e, 0xee, 0xe8, 0xae, 0x4e, 0x17, 0xa1, 0xd6, 0x00, 0xaa, 0x79, 0xae, 0x84, 0x40, 0x79, 0x42,
+	0xc7, 0x81, 0x6c, 0x4b, 0xd5, 0x95, 0xcf, 0xe4, 0x0a, 0x54, 0x59, 0x8a, 0xfd, 0x48, 0x99, 0x1f,
+	0x60, 0x2f, 0x44, 0xbf, 0x2c, 0x96, 0x3a, 0x72, 0x4d, 0xee, 0x40, 0x3d, 0x3a, 0xe5, 0xf1, 0x29,
+	0xf7, 0x86, 0x01, 0xe5, 0xa7, 0x49, 0x80, 0xd5, 0x09, 0x8f, 0x9a, 0x42, 0x0f, 0x14, 0xd8, 0x7a,
+	0x0d, 0xa6, 0x2e, 0x89, 0x34, 0xc1, 0xd4, 0x05, 0xe9, 0x53, 0xb2, 0x25, 0xb9, 0x08, 0x95, 0x69,
+	0x32, 0xf4, 0x98, 0x2f, 0x4f, 0xa9, 0xb9, 0xcb, 0xb8, 0x72, 0x7c, 0x71, 0x3e, 0x7f, 0xcb, 0x06,
+	0x81, 0x87, 0x25, 0xe9, 0xe8, 0x96, 0x04, 0xb0, 0x61, 0xed, 0x6f, 0x26, 0x54, 0xf6, 0x64, 0xe7,
+	0xc8, 0x05, 0x58, 0x0e, 0x69, 0x3f, 0x08, 0x75, 0x58, 0xb5, 0x20, 0xfb, 0x60, 0xa7, 0xdc, 0x1b,
+	0xd3, 0x38, 0xc6, 0xe1, 0x66, 0xb3, 0xbc, 0xbd, 0xd8, 0x29, 0x15, 0xa2, 0x73, 0xc2, 0x29, 0x67,
+	0x83, 0x23, 0xe5, 0xec, 0x42, 0xca, 0xf5, 0x63, 0x4a, 0x9e, 0x82, 0xcd, 0xfc, 0x59, 0x18, 0x35,
+	0xc2, 0xf5, 0x33, 0xc2, 0x38, 0x7e, 0x30, 0xe1, 0x8c, 0xbf, 0xcf, 0x03, 0x31, 0x3f, 0x0b, 0xd4,
+	0xfa, 0x5a, 0x86, 0xda, 0xdc, 0x31, 0xe4, 0x3e, 0x90, 0xe0, 0x1d, 0x0e, 0x60, 0x82, 0x33, 0xcd,
+	0x59, 0xa7, 0x8b, 0x58, 0xcd, 0x2c, 0xb3, 0x11, 0xdd, 0x00, 0x7b, 0xe6, 0x1e, 0xcb, 0x56, 0x55,
+	0x5d, 0xc8, 0xfd, 0x62, 0x72, 0x0b, 0x6a, 0xb9, 0x43, 0x1c, 0x25, 0xaa, 0x67, 0x35, 0x77, 0x25,
+	0x03, 0x7b, 0x88, 0x11, 0x07, 0xaa, 0x61, 0x34, 0x90, 0x21, 0x52, 0xa4, 0x8f, 0xa8, 0xe6, 0xde,
+	0xdf, 0x34, 0xa5, 0x73, 0x28, 0x76, 0x39, 0x3d, 0xd7, 0x92, 0xdb, 0x9d, 0x38, 0x25, 0x8f, 0xc1,
+	0x92, 0x37, 0x6f, 0x80, 0xd4, 0x5e, 0xc6, 0xa3, 0xea, 0x9b, 0x37, 0xce, 0x88, 0xd4, 0xd3, 0x6e,
+	0x6e, 0xbe, 0x81, 0x1c, 0x17, 0x87, 0x5b, 0x91, 0xbb, 0x1f, 0xfc, 0x55, 0x1e, 0x2f, 0x34, 0x03,
+	0x8e, 0x22, 0x3f, 0x98, 0xf1, 0x81, 0xdc, 0x85, 0x46, 0x8a, 0x5c, 0x62, 0xd1, 0xc4, 0xa3, 0xc3,
+	0x21, 0x9b, 0xe0, 0x14, 0x9a, 0xa6, 0xac, 0xff, 0xbc, 0xc6, 0x77, 0x34, 0xdc, 0xfa, 0x08, 0xa6,
+	0x2e, 0xa6, 0xc0, 0x3c, 0xa3, 0xc8, 0xbc, 0xcb, 0x60, 0x65, 0x4d, 0xd2, 0x7d, 0x36, 0x75, 0xd5,
+	0xe4, 0x1a, 0x80, 0x32, 0x15, 0x3a, 0xac, 0x3a, 0x2a, 0xdb, 0xbb, 0x06, 0x36, 0x96, 0xd8, 0xa7,
+	0x7d, 0x16, 0x8a, 0x0c, 0xca, 0xd2, 0x5e, 0x84, 0xda, 0x5b, 0xb0, 0x52, 0x2c, 0x81, 0xac, 0x80,
+	0xb5, 0xe7, 0x9c, 0xec, 0xec, 0x1e, 0xee, 0xef, 0x35, 0xce, 0x11, 0x1b, 0xcc, 0xfd, 0x63, 0xb5,
+	0x30, 0x88, 0x05, 0xe5, 0x93, 0xfd, 0xc3, 0x83, 0xc6, 0x52, 0xeb, 0x8b, 0x01, 0xe7, 0x17, 0xc8,
+	0x75, 0x56, 0xee, 0x57, 0xf1, 0xd6, 0xe6, 0x64, 0x52, 0xc9, 0xcf, 0x00, 0x91, 0x3e, 0x8b, 0xbd,
+	0xec, 0x1e, 0x96, 0xb4, 0x39, 0xce, 0xee, 0x28, 0xca, 0x80, 0xac, 0x4b, 0xe5, 0x2d, 0x9f, 0xff,
+	0x69, 0xcc, 0xed, 0x75, 0xb0, 0x32, 0x94, 0x98, 0x50, 0x7a, 0xf1, 0xa4, 0x87, 0x45, 0xe2, 0xc3,
+	0xcb, 0xbd, 0x9e, 0x2a, 0xd0, 0x79, 0x72, 0xd4, 0x6b, 0x2c, 0xb5, 0x3f, 0x19, 0x50, 0x97, 0x41,
+	0x7e, 0x2f, 0x49, 0x98, 0xbe, 0xd2, 0xe8, 0x82, 0x26, 0x55, 0xa5, 0xe8, 0x4a, 0x51, 0xc2, 0x2b,
+	0x22, 0xcc, 0x28, 0x41, 0xd2, 0xae, 0x34, 0x43, 0xec, 0x78, 0xae, 0x90, 0x5f, 0xa8, 0x56, 0xf9,
+	0x17, 0xaa, 0xd5, 0xfe, 0x00, 0x0d, 0x99, 0xcc, 0x4e, 0x41, 0x80, 0xcf, 0xa6, 0xca, 0x90, 0x25,
+	0xa8, 0x34, 0x33, 0xaa, 0xc8, 0x35, 0x52, 0xe5, 0x12, 0x98, 0x21, 0x55, 0x16, 0xd5, 0xe8, 0x8a,
+	0x58, 0xa2, 0x61, 0x4e, 0xd8, 0xca, 0x0b, 0xc2, 0xf6, 0xd9, 0x80, 0xd5, 0x9f, 0xf4, 0x5b, 0x88,
+	0x27, 0x67, 0xe3, 0x00, 0xd3, 0xd4, 0xc7, 0x67, 0x4b, 0x41, 0xfc, 0x31, 0x7d, 0x37, 0x7b, 0x29,
+	0x30, 0xf9, 0xe2, 0x92, 0xc4, 0x47, 0xdc, 0x2d, 0xc0, 0x42, 0x20, 0x84, 0xeb, 0x30, 0xa1, 0xa3,
+	0x31, 0x72, 0x29, 0xcd, 0x04, 0x02, 0xc1, 0x83, 0x0c, 0x13, 0x2d, 0xf2, 0x93, 0x28, 0x2e, 0x78,
+	0xe9, 0x16, 0x09, 0x34, 0x77, 0x6b, 0xfb, 0x72, 0x5e, 0xdb, 0xdb, 0xff, 0x75, 0x5e, 0xed, 0x11,
+	0x10, 0x79, 0xca, 0xbc, 0x70, 0x16, 0xaf, 0xa7, 0x31, 0x7f, 0x3d, 0xff, 0x28, 0x92, 0xb3, 0x31,
+	0x96, 0x0a, 0x63, 0xdc, 0x7d, 0xf4, 0x66, 0x7b, 0x14, 0x65, 0xb4, 0x66, 0xf2, 0xf3, 0xe2, 0x3e,
+	0x1d, 0x61, 0xa5, 0xdd, 0xe9, 0x56, 0x57, 0xb2, 0xb9, 0x3b, 0xff, 0xe1, 0xf1, 0x18, 0xff, 0xc5,
+	0xfc, 0xfa, 0x15, 0x69, 0xdd, 0xfa, 0x01, 0x26, 0x2a, 0xcd, 0xcc, 0x99, 0x08, 0x00, 0x00,
 }
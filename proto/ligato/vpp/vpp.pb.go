@@ -44,10 +44,13 @@ type ConfigData struct {
 	Vrfs                 []*l3.VrfTable                  `protobuf:"bytes,44,rep,name=vrfs,proto3" json:"vrfs,omitempty"`
 	L3Xconnects          []*l3.L3XConnect                `protobuf:"bytes,45,rep,name=l3xconnects,proto3" json:"l3xconnects,omitempty"`
 	DhcpProxies          []*l3.DHCPProxy                 `protobuf:"bytes,46,rep,name=dhcp_proxies,json=dhcpProxies,proto3" json:"dhcp_proxies,omitempty"`
+	IpReassembly         *l3.IPReassembly                `protobuf:"bytes,47,opt,name=ip_reassembly,json=ipReassembly,proto3" json:"ip_reassembly,omitempty"`
 	Nat44Global          *nat.Nat44Global                `protobuf:"bytes,50,opt,name=nat44_global,json=nat44Global,proto3" json:"nat44_global,omitempty"`
 	Dnat44S              []*nat.DNat44                   `protobuf:"bytes,51,rep,name=dnat44s,proto3" json:"dnat44s,omitempty"`
 	Nat44Interfaces      []*nat.Nat44Interface           `protobuf:"bytes,52,rep,name=nat44_interfaces,json=nat44Interfaces,proto3" json:"nat44_interfaces,omitempty"`
 	Nat44Pools           []*nat.Nat44AddressPool         `protobuf:"bytes,53,rep,name=nat44_pools,json=nat44Pools,proto3" json:"nat44_pools,omitempty"`
+	Nat66Interfaces      []*nat.Nat66Interface           `protobuf:"bytes,54,rep,name=nat66_interfaces,json=nat66Interfaces,proto3" json:"nat66_interfaces,omitempty"`
+	Nat66StaticMappings  []*nat.Nat66StaticMapping       `protobuf:"bytes,55,rep,name=nat66_static_mappings,json=nat66StaticMappings,proto3" json:"nat66_static_mappings,omitempty"`
 	IpsecSpds            []*ipsec.SecurityPolicyDatabase `protobuf:"bytes,60,rep,name=ipsec_spds,json=ipsecSpds,proto3" json:"ipsec_spds,omitempty"`
 	IpsecSas             []*ipsec.SecurityAssociation    `protobuf:"bytes,61,rep,name=ipsec_sas,json=ipsecSas,proto3" json:"ipsec_sas,omitempty"`
 	PuntIpredirects      []*punt.IPRedirect              `protobuf:"bytes,70,rep,name=punt_ipredirects,json=puntIpredirects,proto3" json:"punt_ipredirects,omitempty"`
@@ -185,6 +188,13 @@ func (m *ConfigData) GetDhcpProxies() []*l3.DHCPProxy {
 	return nil
 }
 
+func (m *ConfigData) GetIpReassembly() *l3.IPReassembly {
+	if m != nil {
+		return m.IpReassembly
+	}
+	return nil
+}
+
 func (m *ConfigData) GetNat44Global() *nat.Nat44Global {
 	if m != nil {
 		return m.Nat44Global
@@ -213,6 +223,20 @@ func (m *ConfigData) GetNat44Pools() []*nat.Nat44AddressPool {
 	return nil
 }
 
+func (m *ConfigData) GetNat66Interfaces() []*nat.Nat66Interface {
+	if m != nil {
+		return m.Nat66Interfaces
+	}
+	return nil
+}
+
+func (m *ConfigData) GetNat66StaticMappings() []*nat.Nat66StaticMapping {
+	if m != nil {
+		return m.Nat66StaticMappings
+	}
+	return nil
+}
+
 func (m *ConfigData) GetIpsecSpds() []*ipsec.SecurityPolicyDatabase {
 	if m != nil {
 		return m.IpsecSpds
@@ -363,65 +387,69 @@ func init() {
 func init() { proto.RegisterFile("ligato/vpp/vpp.proto", fileDescriptor_0138a1608d5d59f2) }
 
 var fileDescriptor_0138a1608d5d59f2 = []byte{
-	// 954 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0x6d, 0x6f, 0xdb, 0x36,
-	0x10, 0xc7, 0x51, 0xac, 0xed, 0x1a, 0xda, 0x4d, 0x0a, 0x2e, 0x6b, 0xd9, 0x6c, 0xeb, 0xb2, 0x00,
-	0xc1, 0xb2, 0x36, 0x95, 0xb7, 0x38, 0xeb, 0x8b, 0x35, 0x1b, 0xea, 0x87, 0x3a, 0xf1, 0x60, 0x04,
-	0x1a, 0x1d, 0x0c, 0x43, 0xdf, 0x08, 0xd4, 0x93, 0x43, 0x40, 0x13, 0x09, 0x1e, 0x63, 0x24, 0x5f,
-	0x76, 0x9f, 0x65, 0xe0, 0x49, 0xb2, 0x65, 0x5b, 0x41, 0x5f, 0x48, 0xd0, 0xf1, 0x7e, 0xff, 0x3b,
-	0x8a, 0xbc, 0x23, 0xc9, 0x6e, 0x26, 0x67, 0xc2, 0xaa, 0xce, 0x5c, 0x6b, 0xf7, 0x78, 0xda, 0x28,
-	0xab, 0x28, 0x29, 0x46, 0xbd, 0xb9, 0xd6, 0x7b, 0xac, 0x46, 0x88, 0x30, 0x75, 0x4f, 0x41, 0xad,
-	0x7a, 0xa2, 0xcc, 0x3d, 0xa5, 0xe7, 0xb0, 0xe6, 0x91, 0xb9, 0x4d, 0x4c, 0x2a, 0xa2, 0x04, 0x96,
-	0x9f, 0x25, 0xb6, 0xdf, 0x8c, 0x81, 0x16, 0x79, 0x49, 0xfc, 0x70, 0x0f, 0x61, 0x85, 0xad, 0x82,
-	0x7c, 0x5b, 0x47, 0x34, 0x24, 0x51, 0xf1, 0x6e, 0x08, 0x90, 0x9d, 0x74, 0x42, 0x23, 0xe3, 0x59,
-	0x12, 0xc4, 0xea, 0x5f, 0x21, 0xab, 0x1c, 0x2f, 0x56, 0x91, 0x54, 0x86, 0x0d, 0x91, 0xb3, 0x93,
-	0xce, 0x6d, 0xa4, 0xf2, 0x3c, 0x89, 0x6c, 0x93, 0xac, 0xdb, 0x11, 0xa6, 0x5c, 0xbc, 0xbd, 0xe7,
-	0xab, 0x8e, 0xac, 0xdb, 0xb0, 0x5c, 0x38, 0x7e, 0x5b, 0x4d, 0xf2, 0xe5, 0xaa, 0xc7, 0xa8, 0x9b,
-	0xc5, 0xdf, 0xad, 0x65, 0x99, 0x9b, 0xa6, 0xc5, 0xcf, 0x85, 0x75, 0x4f, 0xe9, 0xd9, 0xab, 0x79,
-	0xf4, 0x4d, 0x6e, 0xf1, 0xd5, 0xe0, 0x03, 0x33, 0x7f, 0x87, 0xaf, 0xc2, 0x77, 0xf0, 0x5f, 0x9b,
-	0x90, 0x81, 0xca, 0x53, 0x39, 0x1b, 0x0a, 0x2b, 0xe8, 0x07, 0x42, 0x96, 0x2b, 0xce, 0xc8, 0xfe,
-	0x17, 0x47, 0xad, 0x93, 0x7d, 0x6f, 0x59, 0x18, 0xde, 0xd2, 0xeb, 0x8d, 0xab, 0x4f, 0x5e, 0xd3,
-	0xd0, 0x5f, 0xc8, 0x23, 0xb7, 0x95, 0xc0, 0x5a, 0x28, 0xfe, 0xe6, 0x1e, 0xf1, 0x54, 0x8b, 0x9c,
-	0x17, 0x24, 0xfd, 0x91, 0x3c, 0x14, 0x51, 0x06, 0x6c, 0x17, 0x15, 0x5f, 0xd5, 0x15, 0xae, 0xba,
-	0x7a, 0x83, 0x09, 0x47, 0x00, 0xc1, 0x30, 0x05, 0xf6, 0x75, 0x03, 0x18, 0xa6, 0x5e, 0xaf, 0x3f,
-	0xe2, 0x08, 0xd0, 0x3e, 0xd9, 0x5e, 0xd9, 0x74, 0x60, 0xaf, 0x36, 0x67, 0x93, 0x9d, 0x78, 0x7d,
-	0x84, 0x86, 0xc8, 0xf0, 0xa7, 0x61, 0xcd, 0x02, 0xfa, 0x86, 0x3c, 0x4c, 0x65, 0x08, 0xec, 0x7b,
-	0x54, 0xbe, 0x58, 0x53, 0x8e, 0xc6, 0xfd, 0x8f, 0xb9, 0x35, 0x77, 0x1c, 0x21, 0x97, 0xb0, 0xaa,
-	0x94, 0x40, 0x0b, 0x69, 0x80, 0xed, 0x37, 0x26, 0xfc, 0x67, 0x50, 0x40, 0xbe, 0x90, 0x86, 0x3f,
-	0xad, 0x24, 0xce, 0x02, 0x7a, 0x4c, 0x1e, 0x63, 0x11, 0x00, 0x3b, 0x42, 0xed, 0xee, 0x8a, 0xb6,
-	0xeb, 0x71, 0xe7, 0xe4, 0x25, 0xe3, 0xa6, 0x27, 0x8c, 0x06, 0xf6, 0x53, 0xc3, 0xf4, 0xba, 0x5e,
-	0x8f, 0xfb, 0xe5, 0xf4, 0x1c, 0x44, 0x4f, 0xc9, 0x96, 0x36, 0xea, 0xf6, 0x2e, 0x10, 0x46, 0xb3,
-	0xd7, 0xfb, 0x0f, 0x1a, 0x14, 0xbe, 0xf3, 0xf7, 0xb8, 0xcf, 0x9f, 0x20, 0xd9, 0x33, 0x9a, 0x8e,
-	0xc8, 0x8e, 0xd4, 0x10, 0x89, 0x3c, 0xc8, 0x13, 0x39, 0xbb, 0x0e, 0x95, 0x61, 0x6f, 0x50, 0xfb,
-	0xdd, 0x9a, 0x76, 0xec, 0x4f, 0x23, 0x91, 0x5f, 0x96, 0x10, 0xdf, 0x2e, 0x54, 0x95, 0xed, 0xa6,
-	0x3a, 0x37, 0x29, 0xb0, 0xe3, 0xc6, 0xa9, 0xfe, 0x6d, 0xd2, 0x2b, 0x11, 0x66, 0x09, 0x47, 0x88,
-	0xbe, 0x27, 0x2d, 0xd7, 0x24, 0xc5, 0xc2, 0x00, 0x7b, 0x8b, 0x9a, 0x97, 0x6b, 0x9a, 0x49, 0xb7,
-	0x5a, 0x48, 0x5e, 0xa7, 0xe9, 0x7b, 0xd2, 0x8e, 0xaf, 0x23, 0x1d, 0xb8, 0x5f, 0x90, 0x09, 0x30,
-	0x0f, 0xd5, 0x6c, 0x4d, 0x3d, 0xbc, 0x18, 0xf8, 0xf8, 0xbb, 0xbc, 0xe5, 0x68, 0xbf, 0x80, 0xe9,
-	0x1f, 0xa4, 0x9d, 0x0b, 0x7b, 0x7a, 0x1a, 0xcc, 0x32, 0x15, 0x8a, 0x8c, 0x9d, 0xe0, 0xbf, 0xae,
-	0xec, 0xa0, 0xeb, 0xb7, 0x4b, 0xc7, 0x9c, 0x23, 0xc2, 0x5b, 0xf9, 0xd2, 0xa0, 0x3f, 0x93, 0x2f,
-	0x63, 0xb4, 0x81, 0x75, 0x31, 0xef, 0xf3, 0x75, 0xe9, 0x10, 0xb5, 0xbc, 0xc2, 0xe8, 0x98, 0x3c,
-	0x2b, 0x32, 0xd6, 0x7a, 0xee, 0x14, 0xa5, 0xaf, 0x1a, 0xb3, 0x2e, 0x3b, 0x6e, 0x27, 0x5f, 0xb1,
-	0x81, 0xf6, 0x48, 0x31, 0x97, 0x40, 0x2b, 0x95, 0x01, 0xfb, 0x75, 0xb3, 0x73, 0x17, 0x51, 0x7a,
-	0x71, 0x6c, 0x12, 0x00, 0x5f, 0xa9, 0x8c, 0x13, 0x14, 0xb9, 0x4f, 0xa0, 0xe7, 0x84, 0xe0, 0x21,
-	0x1a, 0x80, 0x8e, 0x81, 0x9d, 0x61, 0x84, 0xa3, 0x95, 0xf6, 0xc5, 0x23, 0x76, 0x9a, 0x44, 0x37,
-	0x46, 0xda, 0x3b, 0x5f, 0x65, 0x32, 0xba, 0x73, 0xa7, 0x46, 0x28, 0x20, 0xe1, 0x5b, 0xe8, 0x9d,
-	0xea, 0xd8, 0x35, 0xc3, 0x56, 0x19, 0x48, 0x00, 0xfb, 0x1d, 0xe3, 0x1c, 0xde, 0x1f, 0xa7, 0x07,
-	0xa0, 0x22, 0x29, 0xac, 0x54, 0x39, 0x7f, 0x52, 0x04, 0x11, 0x40, 0x47, 0xe4, 0x99, 0x3b, 0xc1,
-	0x02, 0xa9, 0x4d, 0x12, 0x4b, 0x83, 0xb5, 0x30, 0xda, 0x6c, 0x29, 0x3c, 0xe5, 0xc6, 0x3e, 0x2f,
-	0x19, 0xbe, 0xe3, 0x06, 0xc6, 0x4b, 0x0d, 0xfd, 0x8d, 0xb4, 0x31, 0x8e, 0x55, 0xd7, 0x0a, 0x2c,
-	0xb0, 0xf3, 0xcd, 0x1a, 0xc4, 0x18, 0x57, 0xea, 0x42, 0x81, 0xe5, 0x2d, 0x67, 0x5c, 0x15, 0x2c,
-	0x1d, 0x10, 0x0c, 0x17, 0x24, 0xb7, 0x51, 0xa2, 0xdd, 0xfc, 0x80, 0x5d, 0xa0, 0x7c, 0x6f, 0x43,
-	0xfe, 0xb1, 0x42, 0xf8, 0xb6, 0xb3, 0x17, 0x26, 0xd0, 0x33, 0xd2, 0x72, 0xc7, 0x6d, 0x55, 0x54,
-	0xd3, 0xcd, 0xa2, 0xc2, 0xd3, 0x78, 0xca, 0xe7, 0xef, 0xca, 0xa2, 0x22, 0x6e, 0xa0, 0xac, 0xa9,
-	0x0f, 0x64, 0x1b, 0xd5, 0x99, 0x8a, 0x44, 0x06, 0x32, 0x06, 0xe6, 0x6f, 0x36, 0x04, 0x06, 0x98,
-	0x38, 0x62, 0x3a, 0x1e, 0xf2, 0xa7, 0xce, 0x9c, 0x54, 0x3c, 0x3d, 0x23, 0x38, 0x10, 0x68, 0xb7,
-	0x5d, 0xae, 0x27, 0xfe, 0xda, 0x5c, 0x01, 0x0c, 0x50, 0xec, 0x27, 0x6f, 0x3b, 0xc3, 0x2f, 0xe1,
-	0x45, 0x7e, 0xb0, 0x49, 0x62, 0x64, 0x3e, 0x03, 0xc6, 0xef, 0xc9, 0x3f, 0x2d, 0x89, 0x22, 0x7f,
-	0x65, 0xc1, 0xc1, 0x27, 0xd2, 0xbe, 0x54, 0x56, 0xa6, 0x32, 0xc2, 0x2d, 0xa6, 0x7f, 0x92, 0xad,
-	0x45, 0xb5, 0xb3, 0x07, 0xb8, 0x1a, 0xc7, 0x9f, 0xbb, 0x60, 0xea, 0x01, 0xf8, 0x52, 0x7e, 0x30,
-	0x21, 0x8f, 0xa6, 0x56, 0xe0, 0x4e, 0x6d, 0x04, 0x3d, 0xfc, 0x5c, 0x50, 0x54, 0xd6, 0xa2, 0xf5,
-	0x8f, 0x3f, 0xbd, 0x9e, 0xa9, 0x4a, 0x25, 0xf1, 0xba, 0x7c, 0x2b, 0x66, 0x49, 0x6e, 0x3b, 0xf3,
-	0x6e, 0x07, 0x6f, 0xcb, 0xce, 0xf2, 0x22, 0x0d, 0x1f, 0xe3, 0x48, 0xf7, 0xff, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x62, 0x91, 0xea, 0x98, 0x4c, 0x09, 0x00, 0x00,
+	// 1022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x85, 0x56, 0xef, 0x6f, 0xdb, 0x36,
+	0x10, 0x45, 0xb1, 0xb6, 0x6b, 0x68, 0xc7, 0x29, 0xd8, 0xb4, 0x65, 0xd3, 0xad, 0xcb, 0x02, 0x04,
+	0x4b, 0xdb, 0xd4, 0xde, 0xe2, 0xcc, 0x03, 0xb6, 0x6e, 0xa8, 0x7f, 0x34, 0x89, 0x07, 0x2f, 0xd0,
+	0xe8, 0xa0, 0x28, 0xfa, 0x45, 0xa0, 0x64, 0xd9, 0x21, 0xa0, 0x88, 0x82, 0x4e, 0x31, 0xe2, 0xbf,
+	0x6f, 0xff, 0xd8, 0xc8, 0x93, 0x64, 0xc9, 0x96, 0x8c, 0x7e, 0x90, 0xa0, 0xe3, 0xbd, 0xf7, 0x78,
+	0x22, 0xdf, 0x51, 0x22, 0xbb, 0xbe, 0x9c, 0x89, 0x58, 0xb5, 0xe6, 0x61, 0x68, 0xae, 0x66, 0x18,
+	0xa9, 0x58, 0x51, 0x92, 0x8c, 0x36, 0xf5, 0xc8, 0x1e, 0x2b, 0x20, 0x84, 0x33, 0x35, 0x57, 0x82,
+	0x5a, 0xcd, 0xb8, 0xbe, 0xb9, 0xd2, 0xcc, 0x61, 0x21, 0x23, 0x83, 0xd8, 0x8b, 0xa6, 0xc2, 0xf5,
+	0x20, 0x7f, 0x4c, 0x61, 0xfb, 0xd5, 0x30, 0x08, 0x45, 0x90, 0x22, 0x7e, 0xdc, 0x80, 0x88, 0x45,
+	0x9c, 0x89, 0x7c, 0x57, 0x84, 0x84, 0xe0, 0xb9, 0xc9, 0xbd, 0x42, 0xc0, 0x3f, 0x69, 0x39, 0x91,
+	0x9c, 0xcc, 0x3c, 0x7b, 0xa2, 0x6e, 0x84, 0xcc, 0xe6, 0x78, 0xbe, 0x0a, 0x99, 0x4a, 0xa7, 0x42,
+	0x59, 0x27, 0xee, 0x5c, 0x15, 0x04, 0x9e, 0x1b, 0x57, 0xd1, 0xda, 0x2d, 0x11, 0xa5, 0x8b, 0xb7,
+	0xf7, 0x6c, 0x35, 0xe1, 0xb7, 0x2b, 0x96, 0x0b, 0xc7, 0xef, 0xb2, 0x22, 0x5f, 0xac, 0x66, 0x22,
+	0x75, 0xbb, 0x7c, 0xbb, 0xb5, 0x59, 0xe6, 0x51, 0xd5, 0xe2, 0x07, 0x22, 0x36, 0x57, 0x9a, 0xd9,
+	0x2b, 0x64, 0xc2, 0xdb, 0x20, 0xc6, 0x5b, 0x45, 0x0e, 0xa2, 0x79, 0x07, 0x6f, 0x49, 0xee, 0xe0,
+	0xbf, 0x06, 0x21, 0x7d, 0x15, 0x4c, 0xe5, 0x6c, 0x20, 0x62, 0x41, 0x3f, 0x10, 0x92, 0xaf, 0x38,
+	0x23, 0xfb, 0xdf, 0x1c, 0xd5, 0x4e, 0xf6, 0x9b, 0xb9, 0x31, 0x9a, 0x79, 0xb6, 0x39, 0xcc, 0x1e,
+	0x79, 0x81, 0x43, 0x7f, 0x21, 0x0f, 0xcc, 0x56, 0x02, 0xab, 0x21, 0xf9, 0xe5, 0x06, 0xf2, 0x58,
+	0x63, 0x78, 0x82, 0xa4, 0x3f, 0x91, 0xfb, 0xda, 0x45, 0xc0, 0x76, 0x91, 0xf1, 0xa4, 0xc8, 0x30,
+	0xee, 0xea, 0xf6, 0x47, 0x1c, 0x01, 0x08, 0x74, 0xa6, 0xc0, 0x9e, 0x56, 0x00, 0xb5, 0x41, 0xbb,
+	0xbd, 0x33, 0x8e, 0x00, 0xda, 0x23, 0x8d, 0x95, 0x4d, 0x07, 0xf6, 0xaa, 0x5c, 0x8d, 0x7f, 0xd2,
+	0xec, 0x21, 0x68, 0x80, 0x18, 0xbe, 0xed, 0x14, 0x22, 0xa0, 0x6f, 0xc9, 0x7d, 0xed, 0x0a, 0x60,
+	0x3f, 0x20, 0xf3, 0xf9, 0x1a, 0xf3, 0x6c, 0xd8, 0xfb, 0x18, 0xc4, 0xd1, 0x82, 0x23, 0xc8, 0x4c,
+	0x98, 0x39, 0xc5, 0x0e, 0x85, 0x8c, 0x80, 0xed, 0x57, 0x4e, 0xf8, 0xb9, 0x9f, 0x80, 0x2c, 0x8d,
+	0xe1, 0xdb, 0x19, 0xc5, 0x44, 0x40, 0x8f, 0xc9, 0x43, 0x34, 0x01, 0xb0, 0x23, 0xe4, 0xee, 0xae,
+	0x70, 0xdb, 0x4d, 0x6e, 0x92, 0x3c, 0xc5, 0x98, 0xf2, 0xb4, 0xfb, 0x80, 0xbd, 0xae, 0x28, 0xaf,
+	0xdd, 0xec, 0x72, 0x2b, 0x2d, 0xcf, 0x80, 0xe8, 0x29, 0xd9, 0xd2, 0xdb, 0x7d, 0xb7, 0xb0, 0x75,
+	0xc4, 0xde, 0xec, 0xdf, 0xab, 0x60, 0x58, 0x26, 0xaf, 0x69, 0xfc, 0x11, 0x22, 0xbb, 0x51, 0x48,
+	0xcf, 0xc8, 0x8e, 0xee, 0x2a, 0x57, 0x04, 0x76, 0xe0, 0xc9, 0xd9, 0xb5, 0xa3, 0x22, 0xf6, 0x16,
+	0xb9, 0xdf, 0xaf, 0x71, 0x87, 0xd6, 0x58, 0xa3, 0x2e, 0x53, 0x10, 0x6f, 0x24, 0xac, 0x2c, 0x36,
+	0xa5, 0x6a, 0x0b, 0x03, 0x3b, 0xae, 0x2c, 0xf5, 0x53, 0x34, 0xbd, 0x12, 0x8e, 0xef, 0x71, 0x04,
+	0xd1, 0x3f, 0x48, 0xcd, 0x34, 0x49, 0xb2, 0x30, 0xc0, 0xde, 0x21, 0xe7, 0xc5, 0x1a, 0x67, 0xd4,
+	0xce, 0x16, 0x92, 0x17, 0xd1, 0x9a, 0x5c, 0x9f, 0x5c, 0xbb, 0xa1, 0x6d, 0x5e, 0x41, 0xea, 0x85,
+	0x6c, 0x22, 0x9b, 0xad, 0xb1, 0x07, 0x17, 0x7d, 0x0b, 0x5f, 0x97, 0xd7, 0x0c, 0xda, 0x4a, 0xc0,
+	0xda, 0xfb, 0xdb, 0x32, 0xb4, 0x23, 0x4f, 0x00, 0x78, 0x37, 0x8e, 0xbf, 0x60, 0x2d, 0x7c, 0xd9,
+	0x97, 0xa5, 0x97, 0xe5, 0x4b, 0x08, 0xaf, 0xcb, 0x30, 0x8f, 0xe8, 0x5f, 0xa4, 0xae, 0x3b, 0xf2,
+	0xf4, 0xd4, 0x9e, 0xf9, 0xca, 0x11, 0x3e, 0x3b, 0x29, 0x0b, 0x98, 0x8e, 0xbd, 0x34, 0x98, 0x73,
+	0x84, 0xf0, 0x5a, 0x90, 0x07, 0xf4, 0x67, 0xf2, 0xed, 0x04, 0x63, 0x60, 0x6d, 0xac, 0xfc, 0xd9,
+	0x3a, 0x75, 0x80, 0x5c, 0x9e, 0xc1, 0xe8, 0x90, 0x3c, 0x4e, 0x66, 0x2c, 0x74, 0xed, 0x29, 0x52,
+	0x5f, 0x55, 0xce, 0x9a, 0xf7, 0xec, 0x4e, 0xb0, 0x12, 0x03, 0xed, 0x92, 0xa4, 0x16, 0x3b, 0x54,
+	0x4a, 0x37, 0xe3, 0xaf, 0xe5, 0xde, 0x5f, 0xaa, 0x74, 0x27, 0x93, 0xc8, 0x03, 0xb0, 0x34, 0x90,
+	0x13, 0x24, 0x99, 0xc7, 0xac, 0x9a, 0x4e, 0xa7, 0x58, 0x4d, 0x67, 0x63, 0x35, 0x9d, 0xce, 0x6a,
+	0x35, 0x85, 0x18, 0xe8, 0x27, 0xf2, 0x34, 0x91, 0x32, 0xa7, 0xbe, 0x74, 0xed, 0x1b, 0x11, 0x86,
+	0x32, 0x98, 0x01, 0xfb, 0x0d, 0xf5, 0x0e, 0x2a, 0xf5, 0xc6, 0x88, 0xfd, 0x27, 0x81, 0xf2, 0x27,
+	0x41, 0x69, 0x0c, 0xe8, 0xb9, 0x3e, 0xe0, 0xcc, 0x97, 0xc2, 0x86, 0x70, 0x02, 0xec, 0x3d, 0x8a,
+	0x1d, 0xad, 0x9c, 0x51, 0xf8, 0x1d, 0x19, 0x7b, 0xee, 0x6d, 0x24, 0xe3, 0x85, 0xa5, 0x7c, 0xe9,
+	0x2e, 0xcc, 0xd1, 0xe8, 0x08, 0xf0, 0xf8, 0x16, 0x66, 0xc7, 0x9a, 0xaa, 0x3b, 0x7e, 0x2b, 0x15,
+	0x12, 0xc0, 0xfe, 0x44, 0x9d, 0xc3, 0xcd, 0x3a, 0x5d, 0x00, 0xe5, 0x4a, 0x5d, 0x89, 0x0a, 0xf8,
+	0xa3, 0x44, 0x44, 0x80, 0x6e, 0xb0, 0xc7, 0xe6, 0x98, 0xb6, 0x65, 0x18, 0x79, 0x13, 0x19, 0xa1,
+	0xe1, 0xcf, 0xca, 0xe7, 0x06, 0x1e, 0xe5, 0xc6, 0x76, 0x09, 0x86, 0xef, 0x98, 0x81, 0x61, 0xce,
+	0xa1, 0xbf, 0x93, 0x3a, 0xea, 0xc4, 0xea, 0x5a, 0x81, 0xd6, 0x38, 0x2f, 0x37, 0x1a, 0x6a, 0x5c,
+	0xa9, 0x0b, 0x9d, 0xe7, 0x35, 0x13, 0x5c, 0x25, 0x58, 0xda, 0x27, 0x28, 0x67, 0x7b, 0x77, 0xae,
+	0x17, 0x9a, 0xfa, 0x80, 0x5d, 0x20, 0x7d, 0xaf, 0x44, 0xff, 0x98, 0x41, 0x78, 0xc3, 0xc4, 0xcb,
+	0x10, 0xe8, 0x7b, 0x52, 0x33, 0xdf, 0x94, 0xcc, 0xf7, 0xe3, 0xb2, 0xef, 0xf1, 0x93, 0x33, 0xe6,
+	0xf3, 0x4e, 0xea, 0x7b, 0x62, 0x06, 0x52, 0xdb, 0x7f, 0x20, 0x0d, 0x64, 0xfb, 0xca, 0x15, 0x3e,
+	0x48, 0xbd, 0x2f, 0x56, 0xb9, 0xeb, 0x51, 0x60, 0x64, 0x10, 0xe3, 0xe1, 0x80, 0x6f, 0x9b, 0x70,
+	0x94, 0xe1, 0xf5, 0xfc, 0x38, 0xa0, 0xad, 0xab, 0xb7, 0xcb, 0x34, 0xfe, 0xbf, 0xe5, 0x15, 0x40,
+	0x81, 0x64, 0x3f, 0x79, 0xdd, 0x04, 0x56, 0x0a, 0x5e, 0xce, 0x0f, 0xb1, 0xe7, 0x45, 0x68, 0x32,
+	0xbe, 0x61, 0xfe, 0x71, 0x8a, 0x48, 0xe6, 0xcf, 0x22, 0x38, 0xf8, 0x42, 0xea, 0x97, 0x2a, 0x96,
+	0x53, 0xe9, 0xe2, 0x16, 0xd3, 0xbf, 0xb5, 0x39, 0x32, 0x2f, 0xb3, 0x7b, 0xb8, 0x1a, 0xc7, 0x5f,
+	0xfb, 0x8a, 0x16, 0x05, 0x78, 0x4e, 0x3f, 0x18, 0x91, 0x07, 0xc6, 0xc3, 0x66, 0xa7, 0x4a, 0xa2,
+	0x87, 0x5f, 0x13, 0x45, 0x66, 0x41, 0xad, 0x77, 0xfc, 0xe5, 0xcd, 0x4c, 0x65, 0x2c, 0x89, 0xff,
+	0x04, 0xef, 0xc4, 0xcc, 0xd3, 0x3f, 0x0c, 0xf3, 0x76, 0x0b, 0x7f, 0x09, 0x5a, 0xf9, 0xdf, 0x82,
+	0xf3, 0x10, 0x47, 0xda, 0xff, 0x03, 0x9c, 0x14, 0xce, 0xbd, 0x31, 0x0a, 0x00, 0x00,
 }
@@ -48,12 +48,15 @@ type (
 	VRFTable    = vpp_l3.VrfTable
 	L3XConnect    = vpp_l3.L3XConnect
 	DHCPProxy    = vpp_l3.DHCPProxy
+	IPReassembly    = vpp_l3.IPReassembly
 
 	// NAT
 	NAT44Global = vpp_nat.Nat44Global
 	DNAT44      = vpp_nat.DNat44
 	Nat44AddressPool      = vpp_nat.Nat44AddressPool
 	Nat44Interface      = vpp_nat.Nat44Interface
+	Nat66Interface      = vpp_nat.Nat66Interface
+	Nat66StaticMapping      = vpp_nat.Nat66StaticMapping
 
 	// IPSec
 	IPSecSPD = vpp_ipsec.SecurityPolicyDatabase